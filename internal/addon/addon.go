@@ -80,3 +80,13 @@ func (a *Addon) OutboundExtension() addon.OutboundExtension {
 
 	return nil
 }
+
+// DatabaseExtension retrieves the addon implementation from the Symbol field
+// if it implements the addon.DatabaseExtension interface.
+func (a *Addon) DatabaseExtension() addon.DatabaseExtension {
+	if ad, ok := a.Symbol.(addon.DatabaseExtension); ok {
+		return ad
+	}
+
+	return nil
+}
@@ -25,6 +25,11 @@ func (c *Common) Load(ctx *Context, _ *settings.Settings) []*Import {
 		imports["time"] = packages["time"]
 		imports["protostruct"] = packages["protostruct"]
 		imports["prototimestamp"] = packages["prototimestamp"]
+		imports["protoduration"] = packages["protoduration"]
+		imports["protofieldmask"] = packages["protofieldmask"]
+		imports["protowrappers"] = packages["protowrappers"]
+		imports["protoany"] = packages["protoany"]
+		imports["proto"] = packages["proto"]
 	}
 
 	return toSlice(imports)
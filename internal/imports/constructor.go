@@ -0,0 +1,44 @@
+package imports
+
+import (
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/spec"
+)
+
+// Constructor represents the 'api/constructor.tmpl' importer.
+type Constructor struct{}
+
+// Name returns the template name.
+func (c *Constructor) Name() spec.Name {
+	return spec.NewName("api", "constructor")
+}
+
+// Load returns a slice of imports for the template.
+func (c *Constructor) Load(ctx *Context, cfg *settings.Settings) []*Import {
+	if !cfg.Templates.Constructors || len(ctx.DomainMessages) == 0 {
+		return nil
+	}
+
+	imports := make(map[string]*Import)
+
+	for _, msg := range ctx.DomainMessages {
+		for _, f := range msg.Fields {
+			// Oneof members are set through their oneof structures.
+			if f.ProtoField.IsOneof() {
+				continue
+			}
+
+			if addStorageTypePackages(imports, f) {
+				continue
+			}
+			if isWellKnownTypeField(f) || f.IsProtobufTimestamp {
+				addWellKnownPackages(imports, f.DomainType)
+				continue
+			}
+
+			addModuleIfNeeded(imports, "", f.DomainType, ctx.ModuleName, msg.Receiver, ctx.FullPath)
+		}
+	}
+
+	return toSlice(imports)
+}
@@ -34,6 +34,8 @@ func (d *Domain) loadImportsFromMessages(
 	imports := make(map[string]*Import)
 
 	for _, msg := range messages {
+		d.addMongoImports(msg, imports)
+
 		for _, f := range msg.Fields {
 			var (
 				call             = cfg.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr) + "("
@@ -43,6 +45,15 @@ func (d *Domain) loadImportsFromMessages(
 
 			d.addConvertersImport(cfg, conversionToWire, imports)
 
+			if addStorageTypePackages(imports, f) {
+				continue
+			}
+
+			if isWellKnownTypeField(f) {
+				d.addWellKnownTypeImports(f, imports)
+				continue
+			}
+
 			if d.addTimeImport(f, imports) {
 				continue
 			}
@@ -66,6 +77,24 @@ func (d *Domain) loadImportsFromMessages(
 	return imports
 }
 
+func (d *Domain) addMongoImports(msg *Message, imports map[string]*Import) {
+	if msg.MongoNotDeletedFilter != "" {
+		imports["bson"] = packages["bson"]
+	}
+	if len(msg.MongoIndexes) == 0 {
+		return
+	}
+
+	imports["mongo"] = packages["mongo"]
+	imports["bson"] = packages["bson"]
+
+	for _, index := range msg.MongoIndexes {
+		if strings.Contains(index, "options.") {
+			imports["mongo-options"] = packages["mongo-options"]
+		}
+	}
+}
+
 func (d *Domain) addConvertersImport(cfg *settings.Settings, conversionToWire string, imports map[string]*Import) {
 	// Import user converters package?
 	if i, ok := needsUserConvertersPackage(cfg, conversionToWire); ok {
@@ -73,6 +102,15 @@ func (d *Domain) addConvertersImport(cfg *settings.Settings, conversionToWire st
 	}
 }
 
+func (d *Domain) addWellKnownTypeImports(f *Field, imports map[string]*Import) {
+	addWellKnownPackages(imports, f.DomainType, f.ConversionDomainToWire)
+
+	// Wire types are only declared by array and map conversions.
+	if f.IsArray || f.IsMap {
+		addWellKnownPackages(imports, f.WireType)
+	}
+}
+
 func (d *Domain) addTimeImport(f *Field, imports map[string]*Import) bool {
 	// Import time package?
 	if f.IsProtobufTimestamp && strings.Contains(f.DomainType, "time.Time") {
@@ -13,17 +13,21 @@ import (
 
 // Context represents the context template information specific for imports.
 type Context struct {
-	HasValidatableMessage   bool
-	OutboundHasBitflagField bool
-	UseCommonConverters     bool
-	ModuleName              string
-	FullPath                string
-	Methods                 []*Method
-	DomainMessages          []*Message
-	OutboundMessages        []*Message
-	ValidatableMessages     []*Message
-	WireExtensions          []*Message
-	WireInputMessages       []*Message
+	HasValidatableMessage     bool
+	OutboundHasBitflagField   bool
+	UseCommonConverters       bool
+	ModuleName                string
+	FullPath                  string
+	Methods                   []*Method
+	DomainMessages            []*Message
+	OutboundMessages          []*Message
+	ValidatableMessages       []*Message
+	DomainValidatableMessages []*Message
+	WireExtensions            []*Message
+	WireInputMessages         []*Message
+	RepositoryMessages        []*Message
+	QueryValueTypes           []string
+	RepositoryTypes           []string
 }
 
 // Message represents a message.
@@ -33,12 +37,19 @@ type Message struct {
 	Receiver                         string
 	Fields                           []*Field
 	ProtoMessage                     *protobuf.Message
+	MongoIndexes                     []string
+	MongoNotDeletedFilter            string
+	HasSoftDelete                    bool
+	HasDatabaseTimestamps            bool
+	DatabaseKind                     string
 }
 
 // Field represents a field inside a Message.
 type Field struct {
 	IsArray                        bool
+	IsMap                          bool
 	IsProtobufTimestamp            bool
+	DatabaseStorageType            string
 	IsOutboundBitflag              bool
 	IsMessage                      bool
 	OutboundHide                   bool
@@ -51,6 +62,7 @@ type Field struct {
 	TestingBinding                 string
 	TestingCall                    string
 	ValidationCall                 string
+	DomainValidationCall           string
 	ProtoField                     *protobuf.Field
 }
 
@@ -59,6 +71,9 @@ type Method struct {
 	HasRequiredBody    bool
 	HasQueryArguments  bool
 	HasHeaderArguments bool
+	HasRawBody         bool
+	HasUTF8Arguments   bool
+	ArgumentDecoders   []string
 }
 
 // Import represents an import statement inside a template.
@@ -92,6 +107,9 @@ func LoadTemplateImports(ctx *Context, cfg *settings.Settings) map[spec.Name][]*
 			&Outbound{},
 			&Common{},
 			&Validation{},
+			&Repository{},
+			&Query{},
+			&Constructor{},
 			&Testing{},
 			&TestingHTTPServer{},
 		}
@@ -159,6 +177,80 @@ func needsUserConvertersPackage(cfg *settings.Settings, conversionCall string) (
 	return nil, false
 }
 
+var (
+	wellKnownQualifierRe = regexp.MustCompile(`(?:^|[\s\[\]*,])(time|ts|durationpb|fieldmaskpb|wrapperspb|anypb|structpb|proto)\.`)
+	wellKnownPackages    = map[string]string{
+		"time":        "time",
+		"ts":          "prototimestamp",
+		"durationpb":  "protoduration",
+		"fieldmaskpb": "protofieldmask",
+		"wrapperspb":  "protowrappers",
+		"anypb":       "protoany",
+		"structpb":    "protostruct",
+		"proto":       "proto",
+	}
+)
+
+// isWellKnownTypeField checks if the field, or the value of a map field, is
+// of a google.protobuf well-known type.
+func isWellKnownTypeField(f *Field) bool {
+	return f.ProtoField.IsWellKnownType() || strings.HasPrefix(f.ProtoField.MapValueTypeName(), "google.protobuf.")
+}
+
+// addWellKnownPackages imports the packages that qualify well-known types
+// inside the given types or conversion calls.
+func addWellKnownPackages(imports map[string]*Import, s ...string) {
+	for _, v := range s {
+		for _, m := range wellKnownQualifierRe.FindAllStringSubmatch(v, -1) {
+			name := wellKnownPackages[m[1]]
+			imports[name] = packages[name]
+		}
+	}
+}
+
+// addBytesEncodingPackages imports the packages that encode or decode bytes
+// values inside the given conversion calls.
+func addBytesEncodingPackages(imports map[string]*Import, s ...string) {
+	for _, v := range s {
+		if strings.Contains(v, "base64.") {
+			imports["base64"] = packages["base64"]
+		}
+		if strings.Contains(v, "hex.") {
+			imports["hex"] = packages["hex"]
+		}
+	}
+}
+
+// addStorageTypePackages imports the package of the domain type of a field
+// with a database storage type.
+func addStorageTypePackages(imports map[string]*Import, f *Field) bool {
+	switch f.DatabaseStorageType {
+	case "":
+		return false
+	case "date":
+		imports["time"] = packages["time"]
+	default:
+		imports["bson-primitive"] = packages["bson-primitive"]
+	}
+
+	return true
+}
+
+// addDomainTypePackages imports the packages of standalone domain types,
+// like the arguments of generated functions.
+func addDomainTypePackages(imports map[string]*Import, types ...string) {
+	for _, t := range types {
+		t = strings.TrimPrefix(t, "*")
+
+		if strings.HasPrefix(t, "time.") {
+			imports["time"] = packages["time"]
+		}
+		if strings.HasPrefix(t, "primitive.") {
+			imports["bson-primitive"] = packages["bson-primitive"]
+		}
+	}
+}
+
 func addTimeIfNeeded(imports map[string]*Import, f *Field) bool {
 	// Import time package?
 	if f.IsProtobufTimestamp {
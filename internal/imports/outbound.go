@@ -70,6 +70,14 @@ func (o *Outbound) processField(
 		imports["converters"] = i
 	}
 
+	if isWellKnownTypeField(f) {
+		addWellKnownPackages(imports, f.OutboundType)
+		return
+	}
+
+	// Import bytes encoding packages?
+	addBytesEncodingPackages(imports, conversionCall)
+
 	// Import time package?
 	if f.IsProtobufTimestamp {
 		imports["time"] = packages["time"]
@@ -3,6 +3,9 @@ package imports
 // packages represents a list of common packages that can be imported by several
 // templates.
 var packages = map[string]*Import{
+	"base64": {
+		Name: "encoding/base64",
+	},
 	"context": {
 		Name: "context",
 	},
@@ -12,6 +15,9 @@ var packages = map[string]*Import{
 	"fmt": {
 		Name: "fmt",
 	},
+	"hex": {
+		Name: "encoding/hex",
+	},
 	"json": {
 		Name: "encoding/json",
 	},
@@ -30,20 +36,62 @@ var packages = map[string]*Import{
 	"time": {
 		Name: "time",
 	},
+	"utf8": {
+		Name: "unicode/utf8",
+	},
 	"prototimestamp": {
 		Name:  "google.golang.org/protobuf/types/known/timestamppb",
 		Alias: "ts",
 	},
+	"protoduration": {
+		Name: "google.golang.org/protobuf/types/known/durationpb",
+	},
 	"protostruct": {
 		Name: "google.golang.org/protobuf/types/known/structpb",
 	},
+	"protofieldmask": {
+		Name: "google.golang.org/protobuf/types/known/fieldmaskpb",
+	},
+	"protowrappers": {
+		Name: "google.golang.org/protobuf/types/known/wrapperspb",
+	},
+	"protoany": {
+		Name: "google.golang.org/protobuf/types/known/anypb",
+	},
+	"proto": {
+		Name: "google.golang.org/protobuf/proto",
+	},
 	"fasthttp": {
 		Name: "github.com/valyala/fasthttp",
 	},
+	"mongo": {
+		Name: "go.mongodb.org/mongo-driver/mongo",
+	},
+	"mongo-options": {
+		Name: "go.mongodb.org/mongo-driver/mongo/options",
+	},
+	"bson": {
+		Name: "go.mongodb.org/mongo-driver/bson",
+	},
+	"bson-primitive": {
+		Name: "go.mongodb.org/mongo-driver/bson/primitive",
+	},
 	"fasthttp-router": {
 		Name: "github.com/fasthttp/router",
 	},
 	"validation": {
 		Name: "github.com/go-ozzo/ozzo-validation/v4",
 	},
+	"validation-is": {
+		Name: "github.com/go-ozzo/ozzo-validation/v4/is",
+	},
+	"gorm": {
+		Name: "gorm.io/gorm",
+	},
+	"gorm-clause": {
+		Name: "gorm.io/gorm/clause",
+	},
+	"fielderror": {
+		Name: "github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/fielderror",
+	},
 }
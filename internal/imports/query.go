@@ -0,0 +1,37 @@
+package imports
+
+import (
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/spec"
+)
+
+// Query represents the 'api/query.tmpl' importer.
+type Query struct{}
+
+// Name returns the template name.
+func (q *Query) Name() spec.Name {
+	return spec.NewName("api", "query")
+}
+
+// Load returns a slice of imports for the template.
+func (q *Query) Load(ctx *Context, cfg *settings.Settings) []*Import {
+	if !cfg.Templates.Query || len(ctx.DomainMessages) == 0 {
+		return nil
+	}
+
+	imports := make(map[string]*Import)
+
+	for _, msg := range ctx.DomainMessages {
+		switch msg.DatabaseKind {
+		case "mongo":
+			imports["bson"] = packages["bson"]
+		case "gorm":
+			imports["gorm"] = packages["gorm"]
+			imports["gorm-clause"] = packages["gorm-clause"]
+		}
+	}
+
+	addDomainTypePackages(imports, ctx.QueryValueTypes...)
+
+	return toSlice(imports)
+}
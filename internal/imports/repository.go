@@ -0,0 +1,44 @@
+package imports
+
+import (
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/spec"
+)
+
+// Repository represents the 'api/repository.tmpl' importer.
+type Repository struct{}
+
+// Name returns the template name.
+func (r *Repository) Name() spec.Name {
+	return spec.NewName("api", "repository")
+}
+
+// Load returns a slice of imports for the template.
+func (r *Repository) Load(ctx *Context, _ *settings.Settings) []*Import {
+	if len(ctx.RepositoryMessages) == 0 {
+		return nil
+	}
+
+	imports := map[string]*Import{
+		"context": packages["context"],
+	}
+
+	for _, msg := range ctx.RepositoryMessages {
+		switch msg.DatabaseKind {
+		case "mongo":
+			imports["mongo"] = packages["mongo"]
+			imports["bson"] = packages["bson"]
+		case "gorm":
+			imports["gorm"] = packages["gorm"]
+		}
+
+		// Mongo has no automatic timestamps, so the repository sets them.
+		if msg.HasSoftDelete || (msg.HasDatabaseTimestamps && msg.DatabaseKind == "mongo") {
+			imports["time"] = packages["time"]
+		}
+	}
+
+	addDomainTypePackages(imports, ctx.RepositoryTypes...)
+
+	return toSlice(imports)
+}
@@ -23,7 +23,16 @@ func (r *Routes) Load(ctx *Context, _ *settings.Settings) []*Import {
 	for _, m := range ctx.Methods {
 		if m.HasRequiredBody {
 			imports[packages["errors"].Name] = packages["errors"]
-			imports[packages["json"].Name] = packages["json"]
+			if !m.HasRawBody {
+				imports[packages["json"].Name] = packages["json"]
+			}
+		}
+
+		addBytesEncodingPackages(imports, m.ArgumentDecoders...)
+
+		if m.HasUTF8Arguments {
+			imports[packages["errors"].Name] = packages["errors"]
+			imports[packages["utf8"].Name] = packages["utf8"]
 		}
 
 		if m.HasQueryArguments || m.HasHeaderArguments {
@@ -40,6 +40,16 @@ func (t *Testing) Load(ctx *Context, cfg *settings.Settings) []*Import {
 				importTestingRule = true
 			}
 
+			if addStorageTypePackages(imports, f) {
+				continue
+			}
+
+			if isWellKnownTypeField(f) {
+				addConvertersIfNeeded(imports, cfg, binding)
+				addWellKnownPackages(imports, fieldType)
+				continue
+			}
+
 			addModuleIfNeeded(imports, binding, fieldType, ctx.ModuleName, message.Receiver, ctx.FullPath)
 			addConvertersIfNeeded(imports, cfg, binding)
 			addTimeIfNeeded(imports, f)
@@ -2,6 +2,7 @@ package imports
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
@@ -9,6 +10,10 @@ import (
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/spec"
 )
 
+var (
+	isRuleRe = regexp.MustCompile(`\bis\.[A-Z]`)
+)
+
 // Validation represents the 'api/validation.tmpl' importer
 type Validation struct{}
 
@@ -23,6 +28,13 @@ func (v *Validation) Load(ctx *Context, cfg *settings.Settings) []*Import {
 
 	if ctx.HasValidatableMessage {
 		imports["validation"] = packages["validation"]
+
+		if cfg.StructuredValidationErrorsEnabled() {
+			imports["fielderror"] = packages["fielderror"]
+		}
+		if cfg.ContextValidationEnabled() {
+			imports["context"] = packages["context"]
+		}
 	}
 
 	for _, m := range ctx.ValidatableMessages {
@@ -32,6 +44,24 @@ func (v *Validation) Load(ctx *Context, cfg *settings.Settings) []*Import {
 
 		for _, f := range m.Fields {
 			v.processField(ctx, cfg, f, imports)
+			v.addTimeRuleImports(f, imports)
+			v.addStorageTypeRuleImports(f, imports)
+		}
+	}
+
+	for _, m := range ctx.DomainValidatableMessages {
+		if m.ValidationNeedsCustomRuleOptions {
+			imports["errors"] = packages["errors"]
+		}
+
+		for _, f := range m.Fields {
+			// Domain validations use the same rules of the wire ones, so
+			// their calls are handled in the same way.
+			domainField := *f
+			domainField.ValidationCall = f.DomainValidationCall
+
+			v.processField(ctx, cfg, &domainField, imports)
+			v.addTimeRuleImports(&domainField, imports)
 		}
 	}
 
@@ -48,9 +78,12 @@ func (v *Validation) processField(ctx *Context, cfg *settings.Settings, f *Field
 		validation = fieldExtensions.GetValidate()
 	}
 	if validation == nil {
+		v.addBufValidateImports(ctx, f, imports)
 		return
 	}
 
+	v.addMapImports(f, imports)
+
 	if ok := v.addRegexForValidationTemplate(imports, validation); ok {
 		return
 	}
@@ -73,6 +106,14 @@ func (v *Validation) addRegexForValidationTemplate(
 	imports map[string]*Import,
 	validation *extensions.FieldValidateOptions,
 ) bool {
+	// Map keys and values rules don't replace the field rule, so they only
+	// add their import.
+	for _, rules := range []*extensions.FieldValidateOptions{validation.GetKeys(), validation.GetValues()} {
+		if rules.GetRule() == extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_REGEX {
+			imports["regex"] = packages["regex"]
+		}
+	}
+
 	if validation.GetRule() == extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_REGEX {
 		imports["regex"] = packages["regex"]
 		return true
@@ -81,6 +122,67 @@ func (v *Validation) addRegexForValidationTemplate(
 	return false
 }
 
+// addTimeRuleImports adds the imports required by the helpers that validate
+// google.protobuf.Timestamp and google.protobuf.Duration fields.
+func (v *Validation) addTimeRuleImports(f *Field, imports map[string]*Import) {
+	if strings.Contains(f.ValidationCall, "timestampRule(") {
+		imports["time"] = packages["time"]
+		imports["prototimestamp"] = packages["prototimestamp"]
+	}
+	if strings.Contains(f.ValidationCall, "durationRule(") {
+		imports["time"] = packages["time"]
+		imports["protoduration"] = packages["protoduration"]
+	}
+
+	// Domain structures already hold stdlib types.
+	if strings.Contains(f.ValidationCall, "domainTimestampRule(") || strings.Contains(f.ValidationCall, "domainDurationRule(") {
+		imports["time"] = packages["time"]
+	}
+}
+
+// addStorageTypeRuleImports adds the imports required by the helpers that
+// check the format of fields with a database storage type.
+func (v *Validation) addStorageTypeRuleImports(f *Field, imports map[string]*Import) {
+	if strings.Contains(f.ValidationCall, "objectIDRule)") || strings.Contains(f.ValidationCall, "decimal128Rule)") {
+		imports["bson-primitive"] = packages["bson-primitive"]
+	}
+	if strings.Contains(f.ValidationCall, "dateRule)") {
+		imports["time"] = packages["time"]
+	}
+}
+
+// addMapImports adds the imports required by map keys and values
+// validations.
+func (v *Validation) addMapImports(f *Field, imports map[string]*Import) {
+	if f.ProtoField.IsMap() && strings.Contains(f.ValidationCall, "fmt.Sprintf(") {
+		imports["fmt"] = packages["fmt"]
+	}
+}
+
+// addBufValidateImports adds the imports required by validations translated
+// from buf.validate rules.
+func (v *Validation) addBufValidateImports(ctx *Context, f *Field, imports map[string]*Import) {
+	call := f.ValidationCall
+	if call == "" {
+		return
+	}
+
+	if strings.Contains(call, "regexp.MustCompile(") {
+		imports["regex"] = packages["regex"]
+	}
+	if isRuleRe.MatchString(call) {
+		imports["validation-is"] = packages["validation-is"]
+	}
+	v.addMapImports(f, imports)
+
+	// Enum rules reference the enum type, which may belong to another module.
+	if f.ProtoField.IsEnum() {
+		if moduleName, ok := checkImportNeededFromFieldType(f.WireType); ok {
+			imports[moduleName] = importAnotherModule(moduleName, ctx.ModuleName, ctx.FullPath)
+		}
+	}
+}
+
 func (v *Validation) addExternalModuleImport(ctx *Context, field *Field, imports map[string]*Import) {
 	call := field.ValidationCall
 
@@ -128,7 +230,8 @@ func (v *Validation) filterExternalModulesValues(call string) []string {
 			continue
 		}
 
-		value := strings.TrimSpace(parts[1])
+		// Domain validations compare enum values as strings.
+		value := strings.TrimSuffix(strings.TrimSpace(parts[1]), ".ValueWithoutPrefix()")
 		if strings.Contains(value, ".") {
 			values = append(values, value)
 		}
@@ -18,9 +18,9 @@ func (w *Wire) Name() spec.Name {
 // Load returns a slice of imports for the template.
 func (w *Wire) Load(ctx *Context, cfg *settings.Settings) []*Import {
 	imports := map[string]*Import{
-		packages["time"].Name:           packages["time"],
-		packages["prototimestamp"].Name: packages["prototimestamp"],
-		packages["protostruct"].Name:    packages["protostruct"],
+		"time":           packages["time"],
+		"prototimestamp": packages["prototimestamp"],
+		"protostruct":    packages["protostruct"],
 	}
 
 	for k, v := range w.loadImportsFromMessagesToWire(ctx, cfg, ctx.DomainMessages) {
@@ -53,6 +53,21 @@ func (w *Wire) processField(
 	f *Field,
 	imports map[string]*Import,
 ) {
+	if addStorageTypePackages(imports, f) {
+		return
+	}
+
+	if isWellKnownTypeField(f) {
+		addConvertersIfNeeded(imports, cfg, f.ConversionWireToDomain)
+
+		// Domain types are only declared by array and map conversions.
+		if f.IsArray || f.IsMap {
+			addWellKnownPackages(imports, f.DomainType)
+		}
+
+		return
+	}
+
 	if f.IsMessage && !f.IsArray {
 		// Don't need to check non-array messages because they only
 		// call IntoDomain method.
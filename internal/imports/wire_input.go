@@ -42,6 +42,12 @@ func (w *WireInput) loadImportsFromMessages(
 			)
 
 			w.addUserConvertersImport(imports, cfg, conversionToWire)
+
+			if isWellKnownTypeField(f) {
+				w.addWellKnownTypeImports(imports, f, msg)
+				continue
+			}
+
 			w.addTimeImportIfNeeded(imports, f, msg)
 
 			// Skip non-array protobuf timestamps after adding time import (if needed)
@@ -71,6 +77,19 @@ func (w *WireInput) addUserConvertersImport(
 	}
 }
 
+// addWellKnownTypeImports adds the packages used by well-known type fields.
+func (w *WireInput) addWellKnownTypeImports(imports map[string]*Import, f *Field, msg *Message) {
+	addWellKnownPackages(imports, f.ConversionDomainToWire)
+
+	if msg.IsWireInputKind {
+		addWellKnownPackages(imports, f.DomainType)
+	}
+
+	if f.IsArray || f.IsMap {
+		addWellKnownPackages(imports, f.WireType)
+	}
+}
+
 // addTimeImportIfNeeded imports time when handling wire input protobuf timestamps mapped to time.Time.
 func (w *WireInput) addTimeImportIfNeeded(
 	imports map[string]*Import,
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"go/parser"
+	"go/scanner"
 	"go/token"
 	"strings"
 
@@ -19,13 +20,22 @@ import (
 	api_tpl_files "github.com/mikros-dev/protoc-gen-mikros-extensions/internal/template/api"
 	test_tpl_files "github.com/mikros-dev/protoc-gen-mikros-extensions/internal/template/testing"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/ctxutil"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/log"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template"
 	tpl_context "github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/context"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/spec"
 )
 
+const (
+	// sourceExcerptLines is the number of lines shown before and after the
+	// line of a syntax error found in the generated source.
+	sourceExcerptLines = 2
+)
+
 type execution struct {
 	Kind   spec.Kind
 	Path   string
@@ -78,24 +88,63 @@ func handleProtogenPlugin(ctx context.Context, plugin *protogen.Plugin, pluginAr
 	})
 	ctx = ctxutil.WithLogger(ctx, logger)
 
+	packages, err := protobuf.GetPackages(plugin)
+	if err != nil {
+		return err
+	}
+
+	// Each Go package has its own context and generated files. A package
+	// that fails does not prevent the others from being checked, so all
+	// errors are reported at once.
+	var errs diagnostic.List
+	for _, pkg := range packages {
+		errs.Add(generatePackage(ctx, plugin, pkg, pluginArgs, cfg, addons))
+	}
+
+	return errs.Err()
+}
+
+func generatePackage(
+	ctx context.Context,
+	plugin *protogen.Plugin,
+	pkg *protobuf.PackageInfo,
+	pluginArgs *args.Args,
+	cfg *settings.Settings,
+	addons []*addon.Addon,
+) error {
+	logger := ctxutil.LoggerFromContext(ctx)
+
 	// Build the context and execute templates
 	tplContext, err := tpl_context.BuildContext(tpl_context.BuildContextOptions{
 		PluginName: pluginArgs.GetPluginName(),
 		Settings:   cfg,
 		Plugin:     plugin,
 		Addons:     addons,
+		Package:    pkg,
 	})
 	if err != nil {
 		return fmt.Errorf("could not build templates context: %w", err)
 	}
 	logger.Println("processing module:", tplContext.ModuleName)
 
-	executions := buildExecutions(cfg)
+	var (
+		errs       diagnostic.List
+		executions = buildExecutions(cfg)
+	)
+
 	for _, execution := range executions {
-		if err := generateTemplates(ctx, plugin, tplContext, addons, execution); err != nil {
-			return fmt.Errorf("could not generate template: %w", err)
+		if err := generateTemplates(ctx, plugin, pkg, tplContext, addons, execution); err != nil {
+			errs.Add(err)
 		}
 	}
+	if cfg.Templates.SQL {
+		if err := generateSQLSchema(ctx, plugin, pkg, tplContext, cfg); err != nil {
+			errs.Add(err)
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return fmt.Errorf("could not generate template: %w", err)
+	}
 
 	return nil
 }
@@ -103,12 +152,11 @@ func handleProtogenPlugin(ctx context.Context, plugin *protogen.Plugin, pluginAr
 func loadConfigAndAddons(pluginArgs *args.Args) (*settings.Settings, []*addon.Addon, error) {
 	cfg, err := settings.LoadSettings(pluginArgs.SettingsFilename)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not load settings file: %w", err)
-	}
-	if err := cfg.Validate(); err != nil {
-		return nil, nil, fmt.Errorf("invalid settings: %w", err)
+		return nil, nil, diagnostic.InFile(pluginArgs.SettingsFilename, fmt.Errorf("could not load settings file: %w", err))
 	}
 
+	// Addons are loaded before validating the settings because they may
+	// add database kinds.
 	var addonsList []*addon.Addon
 	if cfg.Addons != nil {
 		a, err := addon.LoadAddons(cfg.Addons.Path)
@@ -117,10 +165,36 @@ func loadConfigAndAddons(pluginArgs *args.Args) (*settings.Settings, []*addon.Ad
 		}
 		addonsList = a
 	}
+	if err := registerDatabaseKinds(cfg, addonsList); err != nil {
+		return nil, nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, diagnostic.InFile(pluginArgs.SettingsFilename, fmt.Errorf("invalid settings: %w", err))
+	}
 
 	return cfg, addonsList, nil
 }
 
+func registerDatabaseKinds(cfg *settings.Settings, addons []*addon.Addon) error {
+	for _, a := range addons {
+		ext := a.DatabaseExtension()
+		if ext == nil {
+			continue
+		}
+
+		kind := ext.DatabaseKind()
+		if err := cfg.RegisterDatabaseKind(kind); err != nil {
+			return fmt.Errorf("could not register addon '%s': %w", a.Addon().Name(), err)
+		}
+		if err := mapping.RegisterTagGenerator(kind, ext.NewTagGenerator); err != nil {
+			return fmt.Errorf("could not register addon '%s': %w", a.Addon().Name(), err)
+		}
+	}
+
+	return nil
+}
+
 func buildExecutions(cfg *settings.Settings) []execution {
 	var executions []execution
 	if cfg.Templates.API {
@@ -146,6 +220,7 @@ func buildExecutions(cfg *settings.Settings) []execution {
 func generateTemplates(
 	ctx context.Context,
 	plugin *protogen.Plugin,
+	pkg *protobuf.PackageInfo,
 	tplContext *tpl_context.Context,
 	addons []*addon.Addon,
 	e execution,
@@ -157,6 +232,7 @@ func generateTemplates(
 		Path:             e.Path,
 		FilesPrefix:      e.Prefix,
 		Plugin:           plugin,
+		Package:          pkg,
 		Files:            e.Files,
 		Context:          tplContext,
 		Addons:           addons,
@@ -170,37 +246,75 @@ func generateTemplates(
 		return err
 	}
 
+	var errs diagnostic.List
 	for _, tpl := range generated {
 		logger.Println("generating source file: ", tpl.Filename)
 
 		content := tpl.Data.String()
-		if err := isValidGoSource(content); err != nil {
-			return err
+		if err := isValidGoSource(tpl, content); err != nil {
+			// Keep checking the other files, so all of them are reported.
+			errs.Add(err)
+			continue
 		}
 
 		f := plugin.NewGeneratedFile(tpl.Filename, ".")
 		f.P(content)
 	}
 
-	return nil
+	return errs.Err()
 }
 
-func isValidGoSource(src string) error {
+// isValidGoSource checks if the generated source is valid Go code. Syntax
+// errors point to the template that generated the file and to the lines
+// around the error.
+func isValidGoSource(tpl *template.Generated, src string) error {
 	fileSet := token.NewFileSet()
-	_, err := parser.ParseFile(fileSet, "", src, parser.AllErrors)
+	_, err := parser.ParseFile(fileSet, tpl.Filename, src, parser.AllErrors)
 	if err == nil {
 		return nil
 	}
 
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return diagnostic.InFile(tpl.Filename, fmt.Errorf("template '%s': %w", tpl.TemplateName, err))
+	}
+
 	var (
-		sb    strings.Builder
+		errs  diagnostic.List
 		lines = strings.Split(src, "\n")
 	)
 
-	_, _ = sb.WriteString(err.Error() + "\n")
-	for i, line := range lines {
-		_, _ = sb.WriteString(fmt.Sprintf("%4d: %s\n", i+1, line))
+	for _, e := range list {
+		errs.Add(&diagnostic.Error{
+			Location: fmt.Sprintf("%s:%d:%d", tpl.Filename, e.Pos.Line, e.Pos.Column),
+			Err: fmt.Errorf(
+				"template '%s': %s\n%s",
+				tpl.TemplateName,
+				e.Msg,
+				sourceExcerpt(lines, e.Pos.Line),
+			),
+		})
+	}
+
+	return errs.Err()
+}
+
+// sourceExcerpt returns the lines around a line of the source, marking it.
+func sourceExcerpt(lines []string, line int) string {
+	var (
+		sb    strings.Builder
+		start = max(line-sourceExcerptLines, 1)
+		end   = min(line+sourceExcerptLines, len(lines))
+	)
+
+	for i := start; i <= end; i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+
+		_, _ = sb.WriteString(fmt.Sprintf("%s%4d: %s\n", marker, i, lines[i-1]))
 	}
 
-	return errors.New(sb.String())
+	return strings.TrimSuffix(sb.String(), "\n")
 }
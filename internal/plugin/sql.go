@@ -0,0 +1,102 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/ctxutil"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/sqlschema"
+	tpl_context "github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/context"
+)
+
+type sqlFile struct {
+	Filename string
+	Content  string
+}
+
+// generateSQLSchema generates the SQL schema of the package domain messages,
+// its snapshot and, when enabled, the migration from a previous snapshot.
+func generateSQLSchema(
+	ctx context.Context,
+	plugin *protogen.Plugin,
+	pkg *protobuf.PackageInfo,
+	tplContext *tpl_context.Context,
+	cfg *settings.Settings,
+) error {
+	logger := ctxutil.LoggerFromContext(ctx)
+
+	schema, err := tplContext.SQLSchema()
+	if err != nil {
+		return err
+	}
+	if len(schema.Tables) == 0 {
+		return nil
+	}
+
+	dialect, err := sqlschema.NewDialect(cfg.Database.Dialect)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := schema.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	var (
+		// module name should not have the version suffix.
+		module  = strings.TrimSuffix(pkg.ModuleName, "v1")
+		pkgPath = strings.ReplaceAll(pkg.PackageName, ".", "/")
+		files   = []*sqlFile{
+			{
+				Filename: filepath.Join(cfg.Templates.SQLPath, pkgPath, module+".schema.sql"),
+				Content:  sqlschema.Render(sqlschema.CreateStatements(dialect, schema)),
+			},
+			{
+				Filename: filepath.Join(cfg.Templates.SQLPath, pkgPath, module+".schema.json"),
+				Content:  string(snapshot),
+			},
+		}
+	)
+
+	if m := cfg.Database.Migrations; m != nil {
+		snapshotPath := filepath.Join(m.SnapshotPath, pkgPath, module+".schema.json")
+		previous, err := sqlschema.LoadSnapshot(snapshotPath)
+		if err != nil {
+			return err
+		}
+		if previous.Dialect != "" && previous.Dialect != schema.Dialect {
+			return fmt.Errorf("schema snapshot dialect '%s' differs from '%s'", previous.Dialect, schema.Dialect)
+		}
+
+		migration, err := sqlschema.NewMigration(dialect, previous, schema)
+		if err != nil {
+			return diagnostic.InFile(snapshotPath, err)
+		}
+		if !migration.IsEmpty() {
+			name := filepath.Join(cfg.Templates.SQLPath, pkgPath, "migrations", m.Version+"_"+module)
+			files = append(files,
+				&sqlFile{Filename: name + ".up.sql", Content: sqlschema.Render(migration.Up)},
+				&sqlFile{Filename: name + ".down.sql", Content: sqlschema.Render(migration.Down)},
+			)
+		}
+	}
+
+	for _, file := range files {
+		logger.Println("generating SQL file: ", file.Filename)
+
+		f := plugin.NewGeneratedFile(file.Filename, ".")
+		if _, err := f.Write([]byte(file.Content)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
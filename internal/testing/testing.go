@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/compiler/protogen"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
@@ -13,11 +14,24 @@ import (
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
 )
 
+const (
+	// defaultTimestampWindow is the period, around the current time, used to
+	// create values of google.protobuf.Timestamp fields when their
+	// validation has no 'within' option.
+	defaultTimestampWindow = 30 * 24 * time.Hour
+
+	// defaultDurationWindow is the range of values used for
+	// google.protobuf.Duration fields whose validation only has the
+	// 'min_duration' option.
+	defaultDurationWindow = time.Hour
+)
+
 // Field represents a field for the testing templates.
 type Field struct {
 	isArray  bool
 	goType   string
 	proto    *protobuf.Field
+	message  *protobuf.Message
 	settings *settings.Settings
 	mapping  *mapping.FieldType
 }
@@ -28,6 +42,7 @@ type NewFieldOptions struct {
 	IsArray      bool
 	GoType       string
 	ProtoField   *protobuf.Field
+	Message      *protobuf.Message
 	Settings  *settings.Settings
 	FieldType *mapping.FieldType
 }
@@ -38,6 +53,7 @@ func NewField(options *NewFieldOptions) *Field {
 		isArray:  options.IsArray,
 		goType:   options.GoType,
 		proto:    options.ProtoField,
+		message:  options.Message,
 		settings: options.Settings,
 		mapping:  options.FieldType,
 	}
@@ -45,6 +61,10 @@ func NewField(options *NewFieldOptions) *Field {
 
 // BindingValue returns the binding expression of a field for testing templates.
 func (f *Field) BindingValue(isPointer bool) string {
+	if f.hasStorageType() {
+		return fmt.Sprintf("v.(%s)", f.mapping.DomainForTesting(isPointer))
+	}
+
 	if f.proto.IsTimestamp() {
 		if f.isArray {
 			return "v.([]*time.Time)"
@@ -54,7 +74,7 @@ func (f *Field) BindingValue(isPointer bool) string {
 		return fmt.Sprintf("%s(v.(time.Time))", call)
 	}
 
-	if f.proto.IsProtoStruct() {
+	if f.proto.IsProtoStruct() && !f.isArray {
 		return "v.(map[string]interface{})"
 	}
 
@@ -63,6 +83,10 @@ func (f *Field) BindingValue(isPointer bool) string {
 		return fmt.Sprintf("v.(%v)", outputType)
 	}
 
+	if f.proto.Type == descriptor.FieldDescriptorProto_TYPE_BYTES {
+		return f.bytesValue("v.([]byte)", isPointer)
+	}
+
 	t := f.goType
 	if f.proto.IsEnum() {
 		t = "string"
@@ -84,7 +108,19 @@ func (f *Field) ValueInitCall(isPointer bool) string {
 		return c
 	}
 
-	if f.proto.IsProtoValue() {
+	if c, ok := f.timestampValueInitCall(); ok {
+		return c
+	}
+
+	if c, ok := f.storageTypeValueInitCall(); ok {
+		return c
+	}
+
+	if c, ok := f.durationValueInitCall(); ok {
+		return c
+	}
+
+	if f.proto.IsProtoValue() || f.proto.IsProtoAny() {
 		return "nil"
 	}
 
@@ -96,6 +132,10 @@ func (f *Field) ValueInitCall(isPointer bool) string {
 		)
 	}
 
+	if f.proto.Type == descriptor.FieldDescriptorProto_TYPE_BYTES {
+		return f.bytesValue("[]byte{}", isPointer)
+	}
+
 	if f.proto.Type == descriptor.FieldDescriptorProto_TYPE_STRING {
 		value := `""`
 		if f.proto.IsOptional() {
@@ -129,6 +169,18 @@ func (f *Field) ValueInitCall(isPointer bool) string {
 	return value
 }
 
+// bytesValue returns a bytes value expression matching the domain type of the
+// field. Bytes fields keep being slices when optional, but oneof members are
+// pointers to them.
+func (f *Field) bytesValue(value string, isPointer bool) string {
+	if strings.HasPrefix(f.mapping.DomainForTesting(isPointer), "*") {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+		return fmt.Sprintf("%s(%s)", call, value)
+	}
+
+	return value
+}
+
 func (f *Field) customValueInitCall() (string, bool) {
 	options := extensions.LoadFieldExtensions(f.proto.Proto)
 	if options == nil || options.GetTesting() == nil {
@@ -155,6 +207,147 @@ func (f *Field) customValueInitCall() (string, bool) {
 	return c, true
 }
 
+func (f *Field) hasStorageType() bool {
+	return mapping.FieldStorageType(f.proto) != ""
+}
+
+// storageTypeValueInitCall returns a value of the domain type of fields with
+// a database storage type.
+func (f *Field) storageTypeValueInitCall() (string, bool) {
+	switch mapping.FieldStorageType(f.proto) {
+	case mapping.StorageTypeObjectID:
+		return "primitive.NewObjectID()", true
+	case mapping.StorageTypeDecimal128:
+		return "primitive.NewDecimal128(0, uint64(rand.Int63n(1000000)))", true
+	case mapping.StorageTypeDate:
+		return "time.Now().UTC().Truncate(time.Millisecond)", true
+	default:
+		return "", false
+	}
+}
+
+// timestampValueInitCall returns a random time satisfying the validation
+// rules of google.protobuf.Timestamp fields. Fields related by the 'lt_field'
+// and 'gt_field' options have their values derived from the ones already
+// created.
+func (f *Field) timestampValueInitCall() (string, bool) {
+	if !f.proto.IsTimestamp() || f.isArray {
+		return "", false
+	}
+
+	call, ok := f.nowTimestampValue()
+	after, before := f.relatedTimestamps()
+	if after == "nil" && before == "nil" {
+		return call, ok
+	}
+	if !ok {
+		call = "nil"
+	}
+
+	return fmt.Sprintf("timeBetween(%s, %s, %s)", call, after, before), true
+}
+
+// nowTimestampValue returns a random time satisfying the validation rules
+// relative to the current time.
+func (f *Field) nowTimestampValue() (string, bool) {
+	validate := extensions.LoadFieldExtensions(f.proto.Proto).GetValidate()
+	if !validate.GetLtNow() && !validate.GetGtNow() && validate.GetWithin() == "" {
+		return "", false
+	}
+
+	window := defaultTimestampWindow
+	if d, err := time.ParseDuration(validate.GetWithin()); err == nil && d > 0 {
+		window = d
+	}
+
+	// Keeps values away from the window limits, since the validation happens
+	// after the value is created.
+	var (
+		margin     = window / 10
+		start, end = -(window - margin), window
+	)
+
+	if validate.GetLtNow() {
+		end = -time.Second
+	}
+	if validate.GetGtNow() {
+		start = margin
+	}
+
+	var (
+		offset = int64(start / time.Second)
+		span   = max(int64((end-start)/time.Second), 1)
+		call   = f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+	)
+
+	return fmt.Sprintf("%s(time.Now().Add(time.Duration(%d+rand.Int63n(%d)) * time.Second))", call, offset, span), true
+}
+
+// relatedTimestamps returns the fields, created before the field, that its
+// value must come after and before, following the 'lt_field' and 'gt_field'
+// options declared by both. A missing field is returned as nil.
+func (f *Field) relatedTimestamps() (string, string) {
+	after, before := "nil", "nil"
+	if f.message == nil || f.proto.IsOneof() {
+		return after, before
+	}
+
+	validate := extensions.LoadFieldExtensions(f.proto.Proto).GetValidate()
+	for _, other := range f.message.Fields {
+		if other.Name == f.proto.Name {
+			break
+		}
+		if !other.IsTimestamp() || other.IsArray() || other.IsOneof() {
+			continue
+		}
+
+		var (
+			otherValidate = extensions.LoadFieldExtensions(other.Proto).GetValidate()
+			value         = "res." + other.Schema.GoName
+		)
+
+		if validate.GetGtField() == other.Name || otherValidate.GetLtField() == f.proto.Name {
+			after = value
+		}
+		if validate.GetLtField() == other.Name || otherValidate.GetGtField() == f.proto.Name {
+			before = value
+		}
+	}
+
+	return after, before
+}
+
+// durationValueInitCall returns a random duration satisfying the validation
+// limits of google.protobuf.Duration fields.
+func (f *Field) durationValueInitCall() (string, bool) {
+	if !f.proto.IsDuration() || f.isArray {
+		return "", false
+	}
+
+	var (
+		validate     = extensions.LoadFieldExtensions(f.proto.Proto).GetValidate()
+		minD, minErr = time.ParseDuration(validate.GetMinDuration())
+		maxD, maxErr = time.ParseDuration(validate.GetMaxDuration())
+	)
+
+	if minErr != nil && maxErr != nil {
+		return "", false
+	}
+	if minErr != nil {
+		minD = 0
+	}
+	if maxErr != nil {
+		maxD = minD + defaultDurationWindow
+	}
+
+	var (
+		span = max(int64(maxD-minD), 0) + 1
+		call = f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+	)
+
+	return fmt.Sprintf("%s(time.Duration(%d+rand.Int63n(%d)))", call, int64(minD), span), true
+}
+
 func (f *Field) getEnumTestCallValue() string {
 	var (
 		name               = f.proto.Schema.Enum.GoIdent.GoName
@@ -0,0 +1,327 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+)
+
+// CheckOptions represents the options to check the validation annotations of
+// messages.
+type CheckOptions struct {
+	Messages []*protobuf.Message
+	Settings *settings.Settings
+}
+
+// checkedValue is the value that validate options are applied to: a field or
+// the keys and values of a map field.
+type checkedValue struct {
+	Name    string
+	Desc    protoreflect.FieldDescriptor
+	IsEntry bool
+}
+
+// annotationChecker gathers the problems found in the validate options of a
+// single field.
+type annotationChecker struct {
+	message  *protobuf.Message
+	settings *settings.Settings
+	problems []string
+}
+
+// CheckAnnotations verifies the validate options of every message field
+// before any code is built from them. It checks if options match the field
+// type, compiles regular expressions, resolves referenced fields and detects
+// contradictory options. All problems are reported together, each one with
+// the location of its field.
+//
+// Options of skipped fields are also checked, so misconfigurations are not
+// hidden until the validation is enabled again.
+func CheckAnnotations(options *CheckOptions) error {
+	var problems diagnostic.List
+
+	for _, message := range options.Messages {
+		for _, field := range message.Fields {
+			ext := extensions.LoadFieldExtensions(field.Proto)
+			if ext == nil || ext.GetValidate() == nil || field.Schema == nil {
+				continue
+			}
+
+			checker := &annotationChecker{
+				message:  message,
+				settings: options.Settings,
+			}
+
+			checker.check(ext.GetValidate(), &checkedValue{
+				Name: field.Name,
+				Desc: field.Schema.Desc,
+			})
+			for _, problem := range checker.problems {
+				problems.Add(diagnostic.At(field.Schema.Desc, errors.New(problem)))
+			}
+		}
+	}
+
+	return problems.Err()
+}
+
+func (c *annotationChecker) addProblem(value *checkedValue, format string, args ...interface{}) {
+	c.problems = append(c.problems, fmt.Sprintf("field '%s' %s", value.Name, fmt.Sprintf(format, args...)))
+}
+
+func (c *annotationChecker) check(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	c.checkRequired(rules, value)
+	c.checkDive(rules, value)
+	c.checkConstraints(rules, value)
+	c.checkRule(rules, value)
+	c.checkMap(rules, value)
+	c.checkTime(rules, value)
+
+	if rules.GetErrorMessage() != "" && rules.GetErrorMessageKey() != "" {
+		c.addProblem(value, "cannot have both 'error_message' and 'error_message_key' options")
+	}
+}
+
+func (c *annotationChecker) checkRequired(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	if value.IsEntry {
+		if hasRequiredConditions(rules) {
+			c.addProblem(value, "cannot have conditional 'required_' options")
+		}
+
+		return
+	}
+
+	// The same parser used when building the calls resolves the referenced
+	// fields.
+	condition, err := loadRequiredCondition(&CallOptions{
+		Message: c.message,
+		Options: &extensions.MikrosFieldExtensions{Validate: rules},
+	})
+	if err != nil {
+		c.addProblem(value, "has an invalid 'required_' option: %s", err)
+		return
+	}
+
+	if condition != nil && rules.GetRequired() {
+		c.addProblem(value, "cannot have both 'required' and conditional 'required_' options")
+	}
+}
+
+func (c *annotationChecker) checkDive(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	if !rules.GetDive() {
+		return
+	}
+
+	if value.isMap() || (!value.isList() && value.Desc.Kind() != protoreflect.MessageKind) {
+		c.addProblem(value, "must be an array or a message to have the 'dive' option")
+	}
+}
+
+func (c *annotationChecker) checkConstraints(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	if (rules.GetMin() > 0 || rules.GetMax() > 0) && (value.isCollection(rules) || !value.isNumeric()) {
+		c.addProblem(value, "must be a numeric field to have 'min' or 'max' options")
+	}
+	if rules.GetMin() > 0 && rules.GetMax() > 0 && rules.GetMin() > rules.GetMax() {
+		c.addProblem(value, "has 'min' (%d) greater than 'max' (%d)", rules.GetMin(), rules.GetMax())
+	}
+	if rules.GetMaxLength() > 0 && !value.isList() && !value.isMap() && !value.isString() {
+		c.addProblem(value, "must be a string, bytes, array or map to have the 'max_length' option")
+	}
+	if rules.GetMin() < 0 || rules.GetMax() < 0 || rules.GetMaxLength() < 0 {
+		c.addProblem(value, "cannot have negative 'min', 'max' or 'max_length' options")
+	}
+}
+
+func (c *annotationChecker) checkRule(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	rule := rules.GetRule()
+	if rule != extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_CUSTOM && rules.GetCustomRule() != "" {
+		c.addProblem(value, "must use the FIELD_VALIDATOR_RULE_CUSTOM rule to have the 'custom_rule' option")
+	}
+
+	switch rule {
+	case extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_UNSPECIFIED:
+		if len(rules.GetRuleArgs()) > 0 {
+			c.addProblem(value, "cannot have 'rule_args' without a 'rule' option")
+		}
+
+	case extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_REGEX:
+		if value.isCollection(rules) || value.Desc.Kind() != protoreflect.StringKind {
+			c.addProblem(value, "must be a string to have the regex rule")
+		}
+
+		args := rules.GetRuleArgs()
+		if len(args) == 0 {
+			c.addProblem(value, "has no arguments for the regex rule")
+			return
+		}
+		if _, err := regexp.Compile(args[0]); err != nil {
+			c.addProblem(value, "has an invalid regex rule '%s': %s", args[0], err)
+		}
+
+	case extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_CUSTOM:
+		name := rules.GetCustomRule()
+		if name == "" {
+			c.addProblem(value, "must have the 'custom_rule' option to use the custom rule")
+			return
+		}
+
+		if c.settings == nil {
+			c.addProblem(value, "uses the custom rule '%s' without settings declaring it", name)
+			return
+		}
+
+		ruleSettings, err := c.settings.GetValidationCustomRule(name)
+		if err != nil || ruleSettings == nil {
+			c.addProblem(value, "uses the custom rule '%s' that is not declared in the settings", name)
+			return
+		}
+		if ruleSettings.ArgsRequired && len(rules.GetRuleArgs()) == 0 {
+			c.addProblem(value, "has no arguments for the custom rule '%s'", name)
+		}
+	}
+}
+
+func (c *annotationChecker) checkMap(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	if rules.GetKeys() == nil && rules.GetValues() == nil {
+		return
+	}
+
+	if value.IsEntry {
+		c.addProblem(value, "cannot have keys or values rule options")
+		return
+	}
+	if !value.isMap() {
+		c.addProblem(value, "must be a map to have keys or values rule options")
+		return
+	}
+
+	if keys := rules.GetKeys(); keys != nil {
+		c.check(keys, &checkedValue{
+			Name:    value.Name + ".keys",
+			Desc:    value.Desc.MapKey(),
+			IsEntry: true,
+		})
+	}
+	if values := rules.GetValues(); values != nil {
+		c.check(values, &checkedValue{
+			Name:    value.Name + ".values",
+			Desc:    value.Desc.MapValue(),
+			IsEntry: true,
+		})
+	}
+}
+
+func (c *annotationChecker) checkTime(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	hasTimestampRules := rules.GetLtNow() || rules.GetGtNow() || rules.GetWithin() != "" ||
+		rules.GetLtField() != "" || rules.GetGtField() != ""
+	hasDurationRules := rules.GetMinDuration() != "" || rules.GetMaxDuration() != ""
+
+	if hasTimestampRules {
+		c.checkTimestamp(rules, value)
+	}
+	if hasDurationRules {
+		c.checkDuration(rules, value)
+	}
+}
+
+func (c *annotationChecker) checkTimestamp(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	if value.IsEntry || !value.isMessageOf("google.protobuf.Timestamp") {
+		c.addProblem(value, "must be a google.protobuf.Timestamp to have timestamp rule options")
+	}
+	if rules.GetLtNow() && rules.GetGtNow() {
+		c.addProblem(value, "cannot have both 'lt_now' and 'gt_now' options")
+	}
+	if within := rules.GetWithin(); within != "" {
+		if _, err := parseDuration(value.Name, "within", within); err != nil {
+			c.problems = append(c.problems, err.Error())
+		}
+	}
+	if rules.GetLtField() != "" && rules.GetLtField() == rules.GetGtField() {
+		c.addProblem(value, "cannot have 'lt_field' and 'gt_field' pointing to the same field")
+	}
+
+	c.checkTimestampField(value, "lt_field", rules.GetLtField())
+	c.checkTimestampField(value, "gt_field", rules.GetGtField())
+}
+
+// checkTimestampField checks if an option references another timestamp field
+// of the message.
+func (c *annotationChecker) checkTimestampField(value *checkedValue, option, name string) {
+	if name == "" {
+		return
+	}
+
+	other := findMessageField(c.message, name)
+	if other == nil || !other.IsTimestamp() || other.IsArray() {
+		c.addProblem(value, "has the '%s' option pointing to '%s', which is not a google.protobuf.Timestamp field of the message", option, name)
+	}
+}
+
+func (c *annotationChecker) checkDuration(rules *extensions.FieldValidateOptions, value *checkedValue) {
+	if value.IsEntry || !value.isMessageOf("google.protobuf.Duration") {
+		c.addProblem(value, "must be a google.protobuf.Duration to have duration rule options")
+	}
+
+	minDuration, minOk := c.parseDuration(value, "min_duration", rules.GetMinDuration())
+	maxDuration, maxOk := c.parseDuration(value, "max_duration", rules.GetMaxDuration())
+	if minOk && maxOk && minDuration > maxDuration {
+		c.addProblem(value, "has 'min_duration' (%s) greater than 'max_duration' (%s)", minDuration, maxDuration)
+	}
+}
+
+// parseDuration parses a duration option, if set, reporting it when invalid.
+func (c *annotationChecker) parseDuration(value *checkedValue, option, s string) (time.Duration, bool) {
+	if s == "" {
+		return 0, false
+	}
+
+	d, err := parseDuration(value.Name, option, s)
+	if err != nil {
+		c.problems = append(c.problems, err.Error())
+		return 0, false
+	}
+
+	return d, true
+}
+
+func (v *checkedValue) isList() bool {
+	return !v.IsEntry && v.Desc.IsList()
+}
+
+func (v *checkedValue) isMap() bool {
+	return !v.IsEntry && v.Desc.IsMap()
+}
+
+// isCollection checks if the options are applied to a list or a map itself,
+// instead of to the list elements through the 'dive' option.
+func (v *checkedValue) isCollection(rules *extensions.FieldValidateOptions) bool {
+	return v.isMap() || (v.isList() && !rules.GetDive())
+}
+
+func (v *checkedValue) isString() bool {
+	return v.Desc.Kind() == protoreflect.StringKind || v.Desc.Kind() == protoreflect.BytesKind
+}
+
+func (v *checkedValue) isNumeric() bool {
+	switch v.Desc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed64Kind, protoreflect.FloatKind, protoreflect.DoubleKind:
+		return true
+	}
+
+	return false
+}
+
+func (v *checkedValue) isMessageOf(name protoreflect.FullName) bool {
+	return !v.isList() && !v.isMap() && v.Desc.Kind() == protoreflect.MessageKind && v.Desc.Message().FullName() == name
+}
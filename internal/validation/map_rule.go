@@ -0,0 +1,178 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+// mapEntry represents which part of a map entry is being validated.
+type mapEntry struct {
+	Name       string
+	Rules      *extensions.FieldValidateOptions
+	IsMessage  bool
+	WireType   string
+	DomainType string
+}
+
+func buildMapCall(options *CallOptions) (string, error) {
+	opts := options.Options.GetValidate()
+	if opts.GetKeys() == nil && opts.GetValues() == nil {
+		return "", nil
+	}
+
+	if !options.ProtoField.IsMap() {
+		return "", fmt.Errorf(
+			"field '%s' must be a map to have keys or values rule options",
+			options.ProtoName,
+		)
+	}
+
+	var (
+		desc               = options.ProtoField.Schema.Desc
+		keyType, valueType = mapTypes(options.WireType)
+		_, domainValueType = mapTypes(options.DomainType)
+	)
+
+	keyCall, err := buildMapEntryCall(options, &mapEntry{
+		Name:       "keys",
+		Rules:      opts.GetKeys(),
+		WireType:   keyType,
+		DomainType: keyType,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	valueCall, err := buildMapEntryCall(options, &mapEntry{
+		Name:       "values",
+		Rules:      opts.GetValues(),
+		IsMessage:  desc.MapValue().Kind() == protoreflect.MessageKind,
+		WireType:   strings.TrimPrefix(valueType, "*"),
+		DomainType: strings.TrimPrefix(domainValueType, "*"),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return mapRule(&mapRuleOptions{
+		FieldName:   fmt.Sprintf("%s.%s", options.Receiver, options.memberName(options.ProtoField)),
+		KeyCall:     keyCall,
+		ValueCall:   valueCall,
+		WithContext: options.withContext(),
+	}), nil
+}
+
+func buildMapEntryCall(options *CallOptions, entry *mapEntry) (string, error) {
+	if entry.Rules == nil {
+		return "", nil
+	}
+
+	name := fmt.Sprintf("%s.%s", options.ProtoName, entry.Name)
+	if hasRequiredConditions(entry.Rules) {
+		return "", fmt.Errorf("'%s' cannot have conditional 'required_' options", name)
+	}
+	if entry.Rules.GetKeys() != nil || entry.Rules.GetValues() != nil {
+		return "", fmt.Errorf("'%s' cannot have keys or values rule options", name)
+	}
+
+	return buildCall(&CallOptions{
+		IsMessage:  entry.IsMessage,
+		ProtoName:  name,
+		Receiver:   options.Receiver,
+		WireType:   entry.WireType,
+		Options:    &extensions.MikrosFieldExtensions{Validate: entry.Rules},
+		Settings:   options.Settings,
+		Message:    options.Message,
+		ProtoField: options.ProtoField,
+		Domain:     options.Domain,
+		DomainType: entry.DomainType,
+		FieldName:  options.FieldName,
+	})
+}
+
+func hasRequiredConditions(rules *extensions.FieldValidateOptions) bool {
+	return rules.GetRequiredIf() != "" ||
+		rules.GetRequiredIfNot() != "" ||
+		rules.GetRequiredWith() != "" ||
+		rules.GetRequiredWithout() != "" ||
+		rules.GetRequiredAll() != "" ||
+		rules.GetRequiredAny() != ""
+}
+
+// mapTypes splits a map type into its key and value types.
+func mapTypes(wireType string) (string, string) {
+	key, value, _ := strings.Cut(strings.TrimPrefix(wireType, "map["), "]")
+	return key, value
+}
+
+// mapRuleOptions gathers the information needed to build a map rule.
+type mapRuleOptions struct {
+	FieldName   string
+	KeyCall     string
+	ValueCall   string
+	WithContext bool
+}
+
+// mapRule returns a rule that validates every entry of a map. Errors are
+// keyed by the entry key between brackets, so their paths can be told apart
+// from nested fields.
+func mapRule(options *mapRuleOptions) string {
+	if options.KeyCall == "" && options.ValueCall == "" {
+		return ""
+	}
+
+	var (
+		body      strings.Builder
+		iteration = "mapKey"
+		validate  = "validation.Validate("
+	)
+
+	if options.ValueCall != "" {
+		iteration = "mapKey, mapValue"
+	}
+
+	if options.WithContext {
+		validate = "validation.ValidateWithContext(ctx, "
+		body.WriteString("validation.WithContext(func(ctx context.Context, _ interface{}) error {\n")
+	} else {
+		body.WriteString("validation.By(func(interface{}) error {\n")
+	}
+
+	body.WriteString("errs := validation.Errors{}\n")
+	body.WriteString(fmt.Sprintf("for %s := range %s {\n", iteration, options.FieldName))
+	body.WriteString(`key := fmt.Sprintf("[%v]", mapKey)` + "\n")
+
+	if options.KeyCall != "" {
+		body.WriteString(fmt.Sprintf("if err := %smapKey, %s); err != nil {\n", validate, options.KeyCall))
+		body.WriteString(mapEntryError(options.WithContext))
+		if options.ValueCall != "" {
+			// Values of invalid keys are not validated.
+			body.WriteString("continue\n")
+		}
+		body.WriteString("}\n")
+	}
+	if options.ValueCall != "" {
+		body.WriteString(fmt.Sprintf("if err := %smapValue, %s); err != nil {\n", validate, options.ValueCall))
+		body.WriteString(mapEntryError(options.WithContext) + "}\n")
+	}
+
+	body.WriteString("}\n\nreturn errs.Filter()\n})")
+	return body.String()
+}
+
+// mapEntryError returns the statements that handle an error of a map entry.
+// Context-aware rules may fail with internal errors, which must not be
+// reported as an entry error.
+func mapEntryError(withContext bool) string {
+	if !withContext {
+		return "errs[key] = err\n"
+	}
+
+	return "if ie, ok := err.(validation.InternalError); ok && ie.InternalError() != nil {\n" +
+		"return err\n}\n" +
+		"errs[key] = err\n"
+}
@@ -0,0 +1,587 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+var (
+	// bufTypeRules is the oneof holding the type specific rules of a field.
+	bufTypeRules = (&validate.FieldRules{}).ProtoReflect().Descriptor().Oneofs().ByName("type")
+
+	// bufNumberTypes maps buf.validate numeric rules into the Go type of
+	// their values.
+	bufNumberTypes = map[string]string{
+		"float":    "float32",
+		"double":   "float64",
+		"int32":    "int32",
+		"int64":    "int64",
+		"uint32":   "uint32",
+		"uint64":   "uint64",
+		"sint32":   "int32",
+		"sint64":   "int64",
+		"fixed32":  "uint32",
+		"fixed64":  "uint64",
+		"sfixed32": "int32",
+		"sfixed64": "int64",
+	}
+
+	// bufStringFormats maps buf.validate well-known string formats into
+	// their ozzo 'is' rules.
+	bufStringFormats = map[protoreflect.Name]string{
+		"email":    "is.EmailFormat",
+		"hostname": "is.DNSName",
+		"ip":       "is.IP",
+		"ipv4":     "is.IPv4",
+		"ipv6":     "is.IPv6",
+		"uuid":     "is.UUID",
+	}
+
+	// bufWellKnownRules maps well-known messages into the name of the
+	// buf.validate rules that they accept. Wrappers accept the rules of
+	// the value that they wrap.
+	bufWellKnownRules = map[protoreflect.FullName]string{
+		"google.protobuf.Timestamp":   "timestamp",
+		"google.protobuf.Duration":    "duration",
+		"google.protobuf.Any":         "any",
+		"google.protobuf.DoubleValue": "double",
+		"google.protobuf.FloatValue":  "float",
+		"google.protobuf.Int64Value":  "int64",
+		"google.protobuf.UInt64Value": "uint64",
+		"google.protobuf.Int32Value":  "int32",
+		"google.protobuf.UInt32Value": "uint32",
+		"google.protobuf.BoolValue":   "bool",
+		"google.protobuf.StringValue": "string",
+		"google.protobuf.BytesValue":  "bytes",
+	}
+)
+
+// bufValidateTranslator translates the buf.validate rules of a field into
+// ozzo validation rules, keeping track of rules that have no translation.
+type bufValidateTranslator struct {
+	options     *CallOptions
+	unsupported []string
+}
+
+func buildBufValidateCall(options *CallOptions) (string, error) {
+	if options.BufRules.GetIgnore() == validate.Ignore_IGNORE_ALWAYS {
+		return "", nil
+	}
+
+	t := &bufValidateTranslator{
+		options: options,
+	}
+
+	kind := bufKind(options.ProtoField.Schema.Desc)
+	if options.ProtoField.IsMap() {
+		kind = "map"
+	} else if options.ProtoField.IsArray() {
+		kind = "repeated"
+	}
+
+	parts, err := t.fieldRules("", options.BufRules, kind)
+	if err != nil {
+		return "", err
+	}
+	if len(t.unsupported) > 0 {
+		return "", fmt.Errorf(
+			"field '%s' has buf.validate rules that cannot be translated: %s",
+			options.ProtoName, strings.Join(t.unsupported, ", "),
+		)
+	}
+
+	call := strings.Join(parts, ", ")
+	if call != "" && options.BufRules.GetIgnore() == validate.Ignore_IGNORE_IF_ZERO_VALUE {
+		// Rules like validation.Required, Each or the map one also check
+		// empty values, so the whole call must be skipped for them.
+		call = fmt.Sprintf(
+			"validation.When(!validation.IsEmpty(%s.%s), %s)",
+			options.Receiver, options.memberName(options.ProtoField), call,
+		)
+	}
+
+	return call, nil
+}
+
+// bufKind returns the name of the buf.validate rules accepted by the values
+// of a field.
+func bufKind(desc protoreflect.FieldDescriptor) string {
+	if desc.Kind() == protoreflect.MessageKind {
+		if name, ok := bufWellKnownRules[desc.Message().FullName()]; ok {
+			return bufMessageKind(name)
+		}
+	}
+
+	return desc.Kind().String()
+}
+
+// bufMessageKind marks a rules name as being accepted by a message, whose
+// values cannot be checked by the translated rules.
+func bufMessageKind(name string) string {
+	return "message:" + name
+}
+
+// CheckBufMessageRules checks the buf.validate rules declared by a message
+// itself. None of them can be translated, since validations are built for
+// each field.
+func CheckBufMessageRules(message *protobuf.Message) error {
+	rules := extensions.LoadBufValidateMessageRules(message.Proto)
+	if rules == nil {
+		return nil
+	}
+
+	t := &bufValidateTranslator{}
+	t.checkUnsupported("message", rules.ProtoReflect())
+	if len(t.unsupported) > 0 {
+		return fmt.Errorf(
+			"message '%s' has buf.validate rules that cannot be translated: %s",
+			message.Name, strings.Join(t.unsupported, ", "),
+		)
+	}
+
+	return nil
+}
+
+func (t *bufValidateTranslator) fieldRules(path string, rules *validate.FieldRules, kind string) ([]string, error) {
+	var parts []string
+	if rules.GetRequired() {
+		parts = append(parts, "validation.Required")
+	}
+	if len(rules.GetCel()) > 0 {
+		t.unsupported = append(t.unsupported, joinRulePath(path, "cel"))
+	}
+
+	fd := rules.ProtoReflect().WhichOneof(bufTypeRules)
+	if fd == nil {
+		return parts, nil
+	}
+
+	name := string(fd.Name())
+	if kind == bufMessageKind(name) {
+		// Rules of well-known messages, and of wrappers, would be checked
+		// against the message instead of its value.
+		t.unsupported = append(t.unsupported, joinRulePath(path, name))
+		return parts, nil
+	}
+	if name != kind {
+		return nil, fmt.Errorf(
+			"field '%s' has buf.validate '%s' rules but its type is '%s'",
+			t.options.ProtoName, name, strings.TrimPrefix(kind, bufMessageKind("")),
+		)
+	}
+
+	var (
+		rulesPath = joinRulePath(path, name)
+		m         = rules.ProtoReflect().Get(fd).Message()
+	)
+
+	switch name {
+	case "string":
+		parts = append(parts, t.stringRules(rulesPath, m)...)
+	case "bytes":
+		parts = append(parts, t.bytesRules(rulesPath, m)...)
+	case "bool":
+		parts = append(parts, t.boolRules(rulesPath, m)...)
+	case "enum":
+		parts = append(parts, t.enumRules(rulesPath, m)...)
+	case "repeated":
+		p, err := t.repeatedRules(rulesPath, rules.GetRepeated())
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, p...)
+	case "map":
+		p, err := t.mapRules(rulesPath, rules.GetMap())
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, p...)
+	default:
+		goType, ok := bufNumberTypes[name]
+		if !ok {
+			t.unsupported = append(t.unsupported, rulesPath)
+			break
+		}
+		parts = append(parts, t.numberRules(rulesPath, m, goType)...)
+	}
+
+	return parts, nil
+}
+
+func (t *bufValidateTranslator) numberRules(path string, m protoreflect.Message, goType string) []string {
+	var (
+		parts   []string
+		literal = func(v protoreflect.Value) string {
+			return fmt.Sprintf("%s(%s)", goType, bufNumberLiteral(v, goType))
+		}
+	)
+
+	rangeRules(m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "const":
+			parts = append(parts, fmt.Sprintf("validation.In(%s)", literal(v)))
+		case "gte":
+			parts = append(parts, fmt.Sprintf("validation.Min(%s)", literal(v)))
+		case "gt":
+			parts = append(parts, fmt.Sprintf("validation.Min(%s).Exclusive()", literal(v)))
+		case "lte":
+			parts = append(parts, fmt.Sprintf("validation.Max(%s)", literal(v)))
+		case "lt":
+			parts = append(parts, fmt.Sprintf("validation.Max(%s).Exclusive()", literal(v)))
+		case "in":
+			parts = append(parts, listRule("validation.In", v.List(), literal))
+		case "not_in":
+			parts = append(parts, listRule("validation.NotIn", v.List(), literal))
+		case "example":
+		default:
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	return parts
+}
+
+func (t *bufValidateTranslator) stringRules(path string, m protoreflect.Message) []string {
+	var (
+		parts         []string
+		length, bytes *bufLength
+		quote         = func(v protoreflect.Value) string { return strconv.Quote(v.String()) }
+	)
+
+	rangeRules(m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "const":
+			parts = append(parts, fmt.Sprintf("validation.In(%s)", quote(v)))
+		case "len":
+			lengthOf(&length).exact(v.Uint())
+		case "min_len":
+			lengthOf(&length).minValue = v.Uint()
+		case "max_len":
+			lengthOf(&length).maxValue = v.Uint()
+		case "len_bytes":
+			lengthOf(&bytes).exact(v.Uint())
+		case "min_bytes":
+			lengthOf(&bytes).minValue = v.Uint()
+		case "max_bytes":
+			lengthOf(&bytes).maxValue = v.Uint()
+		case "pattern":
+			parts = append(parts, matchRule(v.String()))
+		case "prefix":
+			parts = append(parts, matchRule("^"+regexp.QuoteMeta(v.String())))
+		case "suffix":
+			parts = append(parts, matchRule(regexp.QuoteMeta(v.String())+"$"))
+		case "contains":
+			parts = append(parts, matchRule(regexp.QuoteMeta(v.String())))
+		case "in":
+			parts = append(parts, listRule("validation.In", v.List(), quote))
+		case "not_in":
+			parts = append(parts, listRule("validation.NotIn", v.List(), quote))
+		case "example":
+		default:
+			if rule, ok := bufStringFormats[fd.Name()]; ok && v.Bool() {
+				parts = append(parts, rule)
+				return
+			}
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	// Length rules are kept before the others, like mikros validations do.
+	var lengthRules []string
+	if length != nil {
+		lengthRules = append(lengthRules, length.rule("validation.RuneLength"))
+	}
+	if bytes != nil {
+		lengthRules = append(lengthRules, bytes.rule("validation.Length"))
+	}
+
+	return append(lengthRules, parts...)
+}
+
+func (t *bufValidateTranslator) bytesRules(path string, m protoreflect.Message) []string {
+	var length *bufLength
+
+	rangeRules(m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "len":
+			lengthOf(&length).exact(v.Uint())
+		case "min_len":
+			lengthOf(&length).minValue = v.Uint()
+		case "max_len":
+			lengthOf(&length).maxValue = v.Uint()
+		case "example":
+		default:
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	if length == nil {
+		return nil
+	}
+
+	return []string{length.rule("validation.Length")}
+}
+
+func (t *bufValidateTranslator) boolRules(path string, m protoreflect.Message) []string {
+	var parts []string
+
+	rangeRules(m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "const":
+			parts = append(parts, fmt.Sprintf("validation.In(%t)", v.Bool()))
+		case "example":
+		default:
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	return parts
+}
+
+func (t *bufValidateTranslator) enumRules(path string, m protoreflect.Message) []string {
+	var (
+		parts    []string
+		enumType = strings.TrimLeft(t.options.WireType, "[]*")
+		literal  = func(v protoreflect.Value) string {
+			// Enums are kept as strings inside domain structures.
+			if t.options.Domain {
+				return fmt.Sprintf("%s(%d).ValueWithoutPrefix()", enumType, v.Int())
+			}
+
+			return fmt.Sprintf("%s(%d)", enumType, v.Int())
+		}
+	)
+
+	rangeRules(m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "const":
+			parts = append(parts, fmt.Sprintf("validation.In(%s)", literal(v)))
+		case "defined_only":
+			if !v.Bool() {
+				return
+			}
+
+			var values []string
+			for _, value := range t.options.ProtoField.Schema.Enum.Values {
+				values = append(values, literal(protoreflect.ValueOfInt32(int32(value.Desc.Number()))))
+			}
+			parts = append(parts, fmt.Sprintf("validation.In(%s)", strings.Join(values, ", ")))
+		case "in":
+			parts = append(parts, listRule("validation.In", v.List(), literal))
+		case "not_in":
+			parts = append(parts, listRule("validation.NotIn", v.List(), literal))
+		case "example":
+		default:
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	return parts
+}
+
+func (t *bufValidateTranslator) repeatedRules(path string, rules *validate.RepeatedRules) ([]string, error) {
+	var (
+		parts  []string
+		length *bufLength
+		items  *validate.FieldRules
+	)
+
+	rangeRules(rules.ProtoReflect(), func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "min_items":
+			lengthOf(&length).minValue = v.Uint()
+		case "max_items":
+			lengthOf(&length).maxValue = v.Uint()
+		case "items":
+			items = rules.GetItems()
+		default:
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	if length != nil {
+		parts = append(parts, length.rule("validation.Length"))
+	}
+	if items != nil {
+		itemRules, err := t.fieldRules(joinRulePath(path, "items"), items, bufKind(t.options.ProtoField.Schema.Desc))
+		if err != nil {
+			return nil, err
+		}
+		if len(itemRules) > 0 {
+			parts = append(parts, fmt.Sprintf("validation.Each(%s)", strings.Join(itemRules, ", ")))
+		}
+	}
+
+	return parts, nil
+}
+
+func (t *bufValidateTranslator) mapRules(path string, rules *validate.MapRules) ([]string, error) {
+	var (
+		parts        []string
+		length       *bufLength
+		keys, values *validate.FieldRules
+		desc         = t.options.ProtoField.Schema.Desc
+	)
+
+	rangeRules(rules.ProtoReflect(), func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Name() {
+		case "min_pairs":
+			lengthOf(&length).minValue = v.Uint()
+		case "max_pairs":
+			lengthOf(&length).maxValue = v.Uint()
+		case "keys":
+			keys = rules.GetKeys()
+		case "values":
+			values = rules.GetValues()
+		default:
+			t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+		}
+	})
+
+	if length != nil {
+		parts = append(parts, length.rule("validation.Length"))
+	}
+
+	var keyRules, valueRules []string
+	if keys != nil {
+		r, err := t.fieldRules(joinRulePath(path, "keys"), keys, bufKind(desc.MapKey()))
+		if err != nil {
+			return nil, err
+		}
+		keyRules = r
+	}
+	if values != nil {
+		// Enum rules need the enum type of the field itself, which map
+		// values don't have.
+		if values.GetEnum() != nil {
+			t.unsupported = append(t.unsupported, joinRulePath(path, "values.enum"))
+		} else {
+			r, err := t.fieldRules(joinRulePath(path, "values"), values, bufKind(desc.MapValue()))
+			if err != nil {
+				return nil, err
+			}
+			valueRules = r
+		}
+	}
+
+	rule := mapRule(&mapRuleOptions{
+		FieldName:   fmt.Sprintf("%s.%s", t.options.Receiver, t.options.memberName(t.options.ProtoField)),
+		KeyCall:     strings.Join(keyRules, ", "),
+		ValueCall:   strings.Join(valueRules, ", "),
+		WithContext: t.options.withContext(),
+	})
+	if rule != "" {
+		parts = append(parts, rule)
+	}
+
+	return parts, nil
+}
+
+// checkUnsupported marks every rule set inside m as unsupported.
+func (t *bufValidateTranslator) checkUnsupported(path string, m protoreflect.Message) {
+	rangeRules(m, func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) {
+		t.unsupported = append(t.unsupported, bufRulePath(path, fd))
+	})
+}
+
+// bufLength holds the length limits of a value.
+type bufLength struct {
+	minValue uint64
+	maxValue uint64
+}
+
+// lengthOf returns the bufLength pointed by l, creating it if needed.
+func lengthOf(l **bufLength) *bufLength {
+	if *l == nil {
+		*l = &bufLength{}
+	}
+
+	return *l
+}
+
+func (l *bufLength) exact(n uint64) {
+	l.minValue = n
+	l.maxValue = n
+}
+
+func (l *bufLength) rule(name string) string {
+	return fmt.Sprintf("%s(%d, %d)", name, l.minValue, l.maxValue)
+}
+
+// rangeRules iterates over all populated fields of a rules message following
+// their declaration order, so the generated code is always the same.
+// Extensions (predefined rules) come last.
+func rangeRules(m protoreflect.Message, fn func(fd protoreflect.FieldDescriptor, v protoreflect.Value)) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); m.Has(fd) {
+			fn(fd, m.Get(fd))
+		}
+	}
+
+	var extensionFields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			extensionFields = append(extensionFields, fd)
+		}
+		return true
+	})
+	sort.Slice(extensionFields, func(i, j int) bool {
+		return extensionFields[i].Number() < extensionFields[j].Number()
+	})
+
+	for _, fd := range extensionFields {
+		fn(fd, m.Get(fd))
+	}
+}
+
+func bufNumberLiteral(v protoreflect.Value, goType string) string {
+	switch goType {
+	case "float32":
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case "float64":
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case "uint32", "uint64":
+		return strconv.FormatUint(v.Uint(), 10)
+	}
+
+	return strconv.FormatInt(v.Int(), 10)
+}
+
+func bufRulePath(path string, fd protoreflect.FieldDescriptor) string {
+	if fd.IsExtension() {
+		return joinRulePath(path, "("+string(fd.FullName())+")")
+	}
+
+	return joinRulePath(path, string(fd.Name()))
+}
+
+func joinRulePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func listRule(name string, values protoreflect.List, format func(protoreflect.Value) string) string {
+	s := make([]string, values.Len())
+	for i := range s {
+		s[i] = format(values.Get(i))
+	}
+
+	return fmt.Sprintf("%s(%s)", name, strings.Join(s, ", "))
+}
+
+func matchRule(expression string) string {
+	return fmt.Sprintf("validation.Match(regexp.MustCompile(%s))", strconv.Quote(expression))
+}
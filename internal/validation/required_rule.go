@@ -243,7 +243,7 @@ func findFieldProtoSpec(
 	for f, field := range message.Field {
 		if name == schema.Fields[f].GoName || name == field.GetJsonName() || name == field.GetName() {
 			return &requiredFieldRuleOptions{
-				FieldName: schema.Fields[f].GoName,
+				FieldName: options.memberName(options.Message.Fields[f]),
 				Type:      field.GetType(),
 				TypeName:  field.GetTypeName(),
 			}, nil
@@ -0,0 +1,35 @@
+package validation
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// storageTypeRules holds the rules that check if string values can be
+// converted into their database storage types. They are declared inside the
+// validation template.
+var storageTypeRules = map[string]string{
+	"objectid":   "objectIDRule",
+	"decimal128": "decimal128Rule",
+	"date":       "dateRule",
+}
+
+// buildStorageTypeCall builds the rule that checks the format of string
+// fields with a database storage type, since invalid values cannot be
+// converted into their domain types.
+func buildStorageTypeCall(options *CallOptions) string {
+	if options.StorageType == "" || options.Domain {
+		return ""
+	}
+	if options.ProtoField == nil || options.ProtoField.Schema.Desc.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	rule, ok := storageTypeRules[options.StorageType]
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("validation.By(%s)", rule)
+}
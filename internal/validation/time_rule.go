@@ -0,0 +1,205 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+)
+
+// buildTimeCall builds the rules of google.protobuf.Timestamp and
+// google.protobuf.Duration fields. They are applied to their Go values,
+// time.Time and time.Duration, by helpers declared inside the validation
+// template.
+func buildTimeCall(options *CallOptions) (string, error) {
+	opts := options.Options.GetValidate()
+	hasTimestampRules := opts.GetLtNow() || opts.GetGtNow() || opts.GetWithin() != "" ||
+		opts.GetLtField() != "" || opts.GetGtField() != ""
+	hasDurationRules := opts.GetMinDuration() != "" || opts.GetMaxDuration() != ""
+
+	if !hasTimestampRules && !hasDurationRules {
+		return "", nil
+	}
+
+	if hasTimestampRules && (!options.ProtoField.IsTimestamp() || options.IsArray) {
+		return "", fmt.Errorf(
+			"field '%s' must be a google.protobuf.Timestamp to have timestamp rule options",
+			options.ProtoName,
+		)
+	}
+	if hasDurationRules && (!options.ProtoField.IsDuration() || options.IsArray) {
+		return "", fmt.Errorf(
+			"field '%s' must be a google.protobuf.Duration to have duration rule options",
+			options.ProtoName,
+		)
+	}
+
+	if hasTimestampRules {
+		return buildTimestampCall(options)
+	}
+
+	return buildDurationCall(options)
+}
+
+func buildTimestampCall(options *CallOptions) (string, error) {
+	var (
+		rules []string
+		opts  = options.Options.GetValidate()
+	)
+
+	if opts.GetLtNow() {
+		rules = append(rules, timeRule("validation.Max(time.Now()).Exclusive()", "lt_now", "must be in the past"))
+	}
+	if opts.GetGtNow() {
+		rules = append(rules, timeRule("validation.Min(time.Now()).Exclusive()", "gt_now", "must be in the future"))
+	}
+	if within := opts.GetWithin(); within != "" {
+		d, err := parseDuration(options.ProtoName, "within", within)
+		if err != nil {
+			return "", err
+		}
+
+		message := fmt.Sprintf("must be within %s of the current time", d)
+		rules = append(rules,
+			timeRule(fmt.Sprintf("validation.Min(time.Now().Add(-%s))", durationLiteral(d)), "within", message),
+			timeRule(fmt.Sprintf("validation.Max(time.Now().Add(%s))", durationLiteral(d)), "within", message),
+		)
+	}
+	if name := opts.GetLtField(); name != "" {
+		rule, err := fieldTimeRule(options, name, "lt_field", "validation.Max", "before")
+		if err != nil {
+			return "", err
+		}
+		rules = append(rules, rule)
+	}
+	if name := opts.GetGtField(); name != "" {
+		rule, err := fieldTimeRule(options, name, "gt_field", "validation.Min", "after")
+		if err != nil {
+			return "", err
+		}
+		rules = append(rules, rule)
+	}
+
+	helper := "timestampRule"
+	if options.Domain {
+		helper = "domainTimestampRule"
+	}
+
+	return fmt.Sprintf("validation.By(%s(%s))", helper, strings.Join(rules, ", ")), nil
+}
+
+func buildDurationCall(options *CallOptions) (string, error) {
+	var (
+		rules []string
+		opts  = options.Options.GetValidate()
+	)
+
+	if value := opts.GetMinDuration(); value != "" {
+		d, err := parseDuration(options.ProtoName, "min_duration", value)
+		if err != nil {
+			return "", err
+		}
+		rules = append(rules, fmt.Sprintf("validation.Min(%s)", durationLiteral(d)))
+	}
+	if value := opts.GetMaxDuration(); value != "" {
+		d, err := parseDuration(options.ProtoName, "max_duration", value)
+		if err != nil {
+			return "", err
+		}
+		rules = append(rules, fmt.Sprintf("validation.Max(%s)", durationLiteral(d)))
+	}
+
+	helper := "durationRule"
+	if options.Domain {
+		helper = "domainDurationRule"
+	}
+
+	return fmt.Sprintf("validation.By(%s(%s))", helper, strings.Join(rules, ", ")), nil
+}
+
+// fieldTimeRule builds a rule comparing the field against another timestamp
+// field of the same message, which is only checked when the other one is
+// set.
+func fieldTimeRule(options *CallOptions, name, code, threshold, relation string) (string, error) {
+	other := findMessageField(options.Message, name)
+	if other == nil {
+		return "", fmt.Errorf("field '%s' references unknown field '%s' in its %s option", options.ProtoName, name, code)
+	}
+	if !other.IsTimestamp() {
+		return "", fmt.Errorf(
+			"field '%s' references field '%s' in its %s option, which is not a google.protobuf.Timestamp",
+			options.ProtoName, name, code,
+		)
+	}
+
+	var (
+		field = fmt.Sprintf("%s.%s", options.Receiver, options.memberName(other))
+		value = fmt.Sprintf("%s.AsTime()", field)
+	)
+
+	if options.Domain {
+		value = fmt.Sprintf("domainTimestampValue(%s)", field)
+	}
+
+	return fmt.Sprintf(
+		"validation.When(%s != nil, %s)",
+		field,
+		timeRule(fmt.Sprintf("%s(%s).Exclusive()", threshold, value), code, fmt.Sprintf("must be %s %s", relation, name)),
+	), nil
+}
+
+func findMessageField(message *protobuf.Message, name string) *protobuf.Field {
+	if message == nil {
+		return nil
+	}
+
+	for _, f := range message.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// timeRule replaces the error of a threshold rule, since their default
+// messages show the threshold value, which is not known by the clients when
+// it is relative to the current time or to another field.
+func timeRule(rule, code, message string) string {
+	return fmt.Sprintf(`%s.ErrorObject(validation.NewError("validation_timestamp_%s", "%s"))`, rule, code, message)
+}
+
+func parseDuration(protoName, option, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("field '%s' has an invalid %s option '%s': %w", protoName, option, value, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("field '%s' has a negative %s option '%s'", protoName, option, value)
+	}
+
+	return d, nil
+}
+
+// durationLiteral returns the Go expression of a duration, using its
+// largest exact unit.
+func durationLiteral(d time.Duration) string {
+	units := []struct {
+		value time.Duration
+		name  string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+	}
+
+	for _, u := range units {
+		if d >= u.value && d%u.value == 0 {
+			return fmt.Sprintf("%d*%s", d/u.value, u.name)
+		}
+	}
+
+	return fmt.Sprintf("time.Duration(%d)", int64(d))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
@@ -14,40 +15,91 @@ import (
 
 // CallOptions represents the options to build a validation call.
 type CallOptions struct {
-	IsArray   bool
-	IsMessage bool
-	ProtoName string
-	Receiver  string
-	WireType  string
-	Options   *extensions.MikrosFieldExtensions
-	Settings  *settings.Settings
-	Message   *protobuf.Message
+	IsArray    bool
+	IsMessage  bool
+	ProtoName  string
+	Receiver   string
+	WireType   string
+	Options    *extensions.MikrosFieldExtensions
+	BufRules   *validate.FieldRules
+	Settings   *settings.Settings
+	Message    *protobuf.Message
+	ProtoField *protobuf.Field
+
+	// StorageType is the database storage type of the field, whose format
+	// is checked along with its rules.
+	StorageType string
+
+	// Domain makes the call validate the domain structure of the message
+	// instead of its wire structure. DomainType and FieldName must be set
+	// along with it.
+	Domain     bool
+	DomainType string
+	FieldName  func(field *protobuf.Field) string
+}
+
+// valueType returns the Go type of the field inside the validated structure.
+func (o *CallOptions) valueType() string {
+	if o.Domain {
+		return o.DomainType
+	}
+
+	return o.WireType
+}
+
+// withContext returns true if the call must propagate the context received
+// by the validation API.
+func (o *CallOptions) withContext() bool {
+	return o.Settings != nil && o.Settings.ContextValidationEnabled()
+}
+
+// memberName returns the name of a field inside the validated structure.
+func (o *CallOptions) memberName(field *protobuf.Field) string {
+	if o.Domain && o.FieldName != nil {
+		return o.FieldName(field)
+	}
+
+	return field.GoName
 }
 
 // Call represents a validation call.
 type Call struct {
-	apiCall string
+	apiCall         string
+	storageTypeRule bool
 }
 
 // NewCall creates a validation call object to retrieve validation expression
 // of fields.
 func NewCall(options *CallOptions) (*Call, error) {
-	var apiCall string
+	var (
+		apiCall     string
+		storageCall string
+	)
+
 	if options != nil {
 		c, err := buildAPICall(options)
 		if err != nil {
 			return nil, err
 		}
-		apiCall = c
+
+		storageCall = buildStorageTypeCall(options)
+		apiCall = joinCallParts([]string{c, storageCall})
 	}
 
 	return &Call{
-		apiCall: apiCall,
+		apiCall:         apiCall,
+		storageTypeRule: storageCall != "",
 	}, nil
 }
 
 func buildAPICall(options *CallOptions) (string, error) {
 	if options.Options == nil || options.Options.GetValidate() == nil {
+		if options.BufRules != nil {
+			// buf.validate rules are only used when the field has no mikros
+			// validation options.
+			return buildBufValidateCall(options)
+		}
+
 		// No validation
 		return "", nil
 	}
@@ -73,11 +125,17 @@ func buildCall(options *CallOptions) (string, error) {
 
 	// Handle required
 	if validationOptions.GetRequired() || requiredCondition != nil {
-		req := "validation.Required"
-		if msg := validationOptions.GetErrorMessage(); msg != "" {
-			req += fmt.Sprintf(`.Error("%s")`, msg)
+		errorCall, err := BuildErrorCall(&ErrorCallOptions{
+			ProtoName:  options.ProtoName,
+			Message:    validationOptions.GetErrorMessage(),
+			MessageKey: validationOptions.GetErrorMessageKey(),
+			Settings:   options.Settings,
+		})
+		if err != nil {
+			return "", err
 		}
-		parts = append(parts, req)
+
+		parts = append(parts, "validation.Required"+errorCall)
 	}
 
 	// Handle dive/message nesting
@@ -92,8 +150,26 @@ func buildCall(options *CallOptions) (string, error) {
 	// Handle constraints (length, min, max)
 	parts = append(parts, buildConstraints(validationOptions)...)
 
+	// Handle map keys and values
+	mapCall, err := buildMapCall(options)
+	if err != nil {
+		return "", err
+	}
+	if mapCall != "" {
+		parts = append(parts, mapCall)
+	}
+
+	// Handle google.protobuf.Timestamp and google.protobuf.Duration rules
+	timeCall, err := buildTimeCall(options)
+	if err != nil {
+		return "", err
+	}
+	if timeCall != "" {
+		parts = append(parts, timeCall)
+	}
+
 	// Handle rules and finalize
-	call := strings.Join(parts, ", ")
+	call := joinCallParts(parts)
 	call, err = handleRule(options, call)
 	if err != nil {
 		return "", err
@@ -102,6 +178,48 @@ func buildCall(options *CallOptions) (string, error) {
 	return handleEndCall(options, requiredCondition, call), nil
 }
 
+// ErrorCallOptions represents the options to build the custom error of a
+// validation rule.
+type ErrorCallOptions struct {
+	ProtoName  string
+	Message    string
+	MessageKey string
+	Settings   *settings.Settings
+}
+
+// BuildErrorCall returns the call that replaces the default error message of
+// a validation rule, if any. Message keys are translated at runtime using the
+// message catalogs from the settings.
+func BuildErrorCall(options *ErrorCallOptions) (string, error) {
+	if options.Message != "" && options.MessageKey != "" {
+		return "", fmt.Errorf("'%s' cannot have both error_message and error_message_key set", options.ProtoName)
+	}
+
+	if options.Message != "" {
+		return fmt.Sprintf(`.Error("%s")`, options.Message), nil
+	}
+
+	if options.MessageKey == "" {
+		return "", nil
+	}
+
+	if options.Settings == nil || !options.Settings.ValidationMessagesEnabled() {
+		return "", fmt.Errorf(
+			"'%s' uses error_message_key '%s' but validations.messages is not set",
+			options.ProtoName, options.MessageKey,
+		)
+	}
+
+	if !options.Settings.HasValidationMessage(options.MessageKey) {
+		return "", fmt.Errorf(
+			"error_message_key '%s' of '%s' not found in the '%s' messages catalog",
+			options.MessageKey, options.ProtoName, options.Settings.DefaultMessagesLocale(),
+		)
+	}
+
+	return fmt.Sprintf(`.Error(validationMessage(options, "%s"))`, options.MessageKey), nil
+}
+
 func buildDiveCall(options *CallOptions) (string, error) {
 	opts := options.Options.GetValidate()
 	if !opts.GetDive() {
@@ -115,15 +233,27 @@ func buildDiveCall(options *CallOptions) (string, error) {
 		)
 	}
 
-	var diveParts []string
 	if options.IsArray {
-		diveParts = append(diveParts, "validation.Each(")
+		if options.IsMessage {
+			// Each element must be validated by its own message validator.
+			elementType := strings.TrimLeft(options.valueType(), "[]*")
+			return fmt.Sprintf("validation.Each(%s)", messageValidatorRule(options, elementType)), nil
+		}
+
+		return "validation.Each(", nil
 	}
-	if options.IsMessage {
-		diveParts = append(diveParts, fmt.Sprintf("validation.By(%vValidator(options...)", options.WireType))
+
+	return messageValidatorRule(options, strings.TrimLeft(options.valueType(), "*")), nil
+}
+
+// messageValidatorRule returns the (still open) rule that validates a field
+// using the validator of its message type.
+func messageValidatorRule(options *CallOptions, messageType string) string {
+	if options.withContext() {
+		return fmt.Sprintf("validation.WithContext(%vContextValidator(options...)", messageType)
 	}
 
-	return strings.Join(diveParts, ", "), nil
+	return fmt.Sprintf("validation.By(%vValidator(options...)", messageType)
 }
 
 func buildConstraints(opts *extensions.FieldValidateOptions) []string {
@@ -167,6 +297,11 @@ func buildConditionalValidationCall(options *CallOptions, condition *requiredCon
 			value = fmt.Sprintf(`"%s"`, rule.Value)
 		}
 
+		// Enums are kept as strings inside domain structures.
+		if options.Domain && rule.Type == descriptor.FieldDescriptorProto_TYPE_ENUM && value != "" {
+			value = fmt.Sprintf("%s.ValueWithoutPrefix()", value)
+		}
+
 		args += fmt.Sprintf("%s.%s %s %s", options.Receiver, rule.FieldName,
 			operation, value)
 
@@ -234,6 +369,20 @@ func handleEndCall(options *CallOptions, requiredCondition *requiredCondition, c
 	return call
 }
 
+// joinCallParts joins validation calls, taking care of not adding a separator
+// right after a call that is still open, like validation.Each(.
+func joinCallParts(parts []string) string {
+	var call string
+	for _, p := range parts {
+		if needsComma(call) {
+			call += ", "
+		}
+		call += p
+	}
+
+	return call
+}
+
 func needsComma(call string) bool {
 	return call != "" && !strings.HasSuffix(call, "(")
 }
@@ -242,3 +391,9 @@ func needsComma(call string) bool {
 func (c *Call) APICall() string {
 	return c.apiCall
 }
+
+// HasStorageTypeRule returns true if the call checks the format of a field
+// with a database storage type.
+func (c *Call) HasStorageTypeRule() bool {
+	return c.storageTypeRule
+}
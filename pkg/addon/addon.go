@@ -3,6 +3,8 @@ package addon
 import (
 	"embed"
 
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/template/spec"
 )
@@ -41,6 +43,19 @@ type OutboundExtension interface {
 	IntoOutbound(msg interface{}, receiver string) string
 }
 
+// DatabaseExtension is an interface that allows the addon to support a new
+// database kind, generating the database struct tags of domain messages.
+type DatabaseExtension interface {
+	// DatabaseKind returns the name of the database kind, which is accepted
+	// as the settings database kind.
+	DatabaseKind() string
+
+	// NewTagGenerator must return the generator of the struct tags of a
+	// field. It may also implement mapping.DatabaseFieldNamer so the
+	// generated code knows the field name inside the database.
+	NewTagGenerator(defs *extensions.MikrosFieldExtensions) mapping.TagGenerator
+}
+
 // Import represents an import statement in a code template, which includes
 // an optional alias and the package name.
 type Import struct {
@@ -16,6 +16,9 @@
 //   - OutboundExtension: an optional interface that lets an addon inject custom
 //     code into generated “IntoOutbound” conversion functions.
 //
+//   - DatabaseExtension: an optional interface that lets an addon support a
+//     new database kind by generating the database struct tags of domains.
+//
 //   - Import: describes template imports (with optional alias) that an addon
 //     may contribute during code generation.
 //
@@ -0,0 +1,135 @@
+// Package diagnostic attaches source locations to the errors found while
+// generating code and aggregates them, so that all problems of a run can be
+// reported at once.
+package diagnostic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Error is an error attached to the location where it was found.
+type Error struct {
+	// Location is where the error was found, usually in the
+	// 'file:line:column' format.
+	Location string
+
+	// Err is the error itself.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Location == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", e.Location, e.Err)
+}
+
+// Unwrap returns the original error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Location returns where a descriptor is declared, in the 'file:line:column'
+// format. When the descriptor has no source information, only its file is
+// returned.
+func Location(desc protoreflect.Descriptor) string {
+	if desc == nil {
+		return ""
+	}
+
+	file := desc.ParentFile()
+	if file == nil {
+		return string(desc.FullName())
+	}
+
+	loc := file.SourceLocations().ByDescriptor(desc)
+	if loc.Path == nil {
+		return file.Path()
+	}
+
+	return fmt.Sprintf("%s:%d:%d", file.Path(), loc.StartLine+1, loc.StartColumn+1)
+}
+
+// At attaches the location of a descriptor to an error. Errors that already
+// have a location keep it, since it was attached closer to their origin.
+func At(desc protoreflect.Descriptor, err error) error {
+	return attach(Location(desc), err)
+}
+
+// InFile attaches a file name as the location of an error.
+func InFile(filename string, err error) error {
+	return attach(filename, err)
+}
+
+func attach(location string, err error) error {
+	if err == nil || location == "" {
+		return err
+	}
+
+	var list List
+	if errors.As(err, &list) {
+		located := make(List, len(list))
+		for i, e := range list {
+			located[i] = attach(location, e)
+		}
+
+		return located
+	}
+
+	var d *Error
+	if errors.As(err, &d) {
+		return err
+	}
+
+	return &Error{
+		Location: location,
+		Err:      err,
+	}
+}
+
+// List aggregates multiple errors.
+type List []error
+
+// Add appends an error to the list. Nil errors are ignored and lists are
+// flattened.
+func (l *List) Add(err error) {
+	if err == nil {
+		return
+	}
+
+	var list List
+	if errors.As(err, &list) {
+		*l = append(*l, list...)
+		return
+	}
+
+	*l = append(*l, err)
+}
+
+// Err returns the list as an error, or nil if it is empty.
+func (l List) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	return l
+}
+
+func (l List) Error() string {
+	s := make([]string, len(l))
+	for i, err := range l {
+		s[i] = err.Error()
+	}
+
+	return strings.Join(s, "\n")
+}
+
+// Unwrap returns the aggregated errors.
+func (l List) Unwrap() []error {
+	return l
+}
@@ -0,0 +1,233 @@
+// Package fielderror holds the structured validation errors returned by the
+// generated Validate API when the 'validations.structured_errors' setting is
+// enabled.
+package fielderror
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Rule codes reported by the validations.
+const (
+	CodeRequired  = "required"
+	CodeLength    = "length"
+	CodeMinLength = "min_length"
+	CodeMaxLength = "max_length"
+	CodeMin       = "min"
+	CodeMax       = "max"
+	CodeRegex     = "regex"
+	CodeIn        = "in"
+	CodeNotIn     = "not_in"
+	CodeLtNow     = "lt_now"
+	CodeGtNow     = "gt_now"
+	CodeWithin    = "within"
+	CodeLtField   = "lt_field"
+	CodeGtField   = "gt_field"
+	CodeInvalid   = "invalid"
+)
+
+// Error represents a validation failure of a single field.
+type Error struct {
+	// Path is the full field path, using the protobuf field names, with array
+	// indexes and map keys between brackets, e.g., "items[0].name".
+	Path string `json:"path"`
+
+	// Code identifies which rule failed.
+	Code string `json:"code"`
+
+	// Message is the human-readable validation message.
+	Message string `json:"message"`
+
+	// Params holds the rule parameters, if any.
+	Params map[string]interface{} `json:"params,omitempty"`
+
+	// Location is where the field was received inside an HTTP request, such
+	// as body, query, path or header.
+	Location string `json:"location,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Errors is the list of validation failures of a message.
+type Errors []*Error
+
+func (es Errors) Error() string {
+	s := make([]string, len(es))
+	for i, e := range es {
+		s[i] = e.Error()
+	}
+
+	return strings.Join(s, "; ")
+}
+
+// FromValidation converts an error returned by ozzo-validation into Errors.
+// Internal errors, i.e., errors not related to the validated values, are
+// returned as is.
+func FromValidation(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var ie validation.InternalError
+	if errors.As(err, &ie) && ie.InternalError() != nil {
+		return err
+	}
+
+	errs := flatten(err, "", "")
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+func flatten(err error, path, location string) Errors {
+	switch e := err.(type) {
+	case nil:
+		return nil
+
+	case Errors:
+		// Already converted, usually by the Validate of a nested message.
+		var out Errors
+		for _, fe := range e {
+			out = append(out, prefix(fe, path, location))
+		}
+
+		return out
+
+	case *Error:
+		return Errors{prefix(e, path, location)}
+
+	case validation.Errors:
+		keys := make([]string, 0, len(e))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var out Errors
+		for _, k := range keys {
+			name, loc := splitKey(k)
+			if loc == "" {
+				loc = location
+			}
+
+			out = append(out, flatten(e[k], joinPath(path, name), loc)...)
+		}
+
+		return out
+
+	case validation.Error:
+		return Errors{{
+			Path:     path,
+			Code:     ruleCode(e),
+			Message:  e.Error(),
+			Params:   e.Params(),
+			Location: location,
+		}}
+	}
+
+	return Errors{{
+		Path:     path,
+		Code:     CodeInvalid,
+		Message:  err.Error(),
+		Location: location,
+	}}
+}
+
+func prefix(e *Error, path, location string) *Error {
+	c := *e
+	c.Path = joinPath(path, e.Path)
+	if location != "" {
+		c.Location = location
+	}
+
+	return &c
+}
+
+// splitKey splits keys from HTTP services, which are in the format
+// 'name@location'.
+func splitKey(key string) (string, string) {
+	if name, location, ok := strings.Cut(key, "@"); ok {
+		return name, location
+	}
+
+	return key, ""
+}
+
+func joinPath(path, name string) string {
+	switch {
+	case name == "":
+		return path
+	case isIndex(name):
+		return fmt.Sprintf("%s[%s]", path, name)
+	case path == "":
+		return name
+	case strings.HasPrefix(name, "["):
+		return path + name
+	}
+
+	return path + "." + name
+}
+
+func isIndex(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+func ruleCode(e validation.Error) string {
+	switch code := e.Code(); code {
+	case validation.ErrRequired.Code(), validation.ErrNilOrNotEmpty.Code():
+		return CodeRequired
+
+	case validation.ErrLengthTooLong.Code():
+		return CodeMaxLength
+
+	case validation.ErrLengthTooShort.Code():
+		return CodeMinLength
+
+	case validation.ErrLengthOutOfRange.Code():
+		// Empty values are not checked by length rules, so a range starting
+		// at 1 can only fail by exceeding its maximum.
+		if minLength, ok := e.Params()["min"].(int); ok && minLength == 1 {
+			return CodeMaxLength
+		}
+
+		return CodeLength
+
+	case validation.ErrLengthInvalid.Code(), validation.ErrLengthEmptyRequired.Code():
+		return CodeLength
+
+	case validation.ErrMinGreaterEqualThanRequired.Code(), validation.ErrMinGreaterThanRequired.Code():
+		return CodeMin
+
+	case validation.ErrMaxLessEqualThanRequired.Code(), validation.ErrMaxLessThanRequired.Code():
+		return CodeMax
+
+	case validation.ErrMatchInvalid.Code():
+		return CodeRegex
+
+	case validation.ErrInInvalid.Code():
+		return CodeIn
+
+	case validation.ErrNotInInvalid.Code():
+		return CodeNotIn
+
+	default:
+		// Timestamp rules, rules from the 'is' package and custom rules keep
+		// their own codes.
+		if c, ok := strings.CutPrefix(code, "validation_timestamp_"); ok {
+			return c
+		}
+
+		return strings.TrimPrefix(code, "validation_is_")
+	}
+}
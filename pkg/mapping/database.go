@@ -1,7 +1,11 @@
 package mapping
 
 import (
+	"fmt"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
 )
 
 // TagGenerator defines the contract for generating database-specific struct tags.
@@ -10,6 +14,34 @@ type TagGenerator interface {
 	GenerateTag(fieldName string) string
 }
 
+// DatabaseFieldNamer is an optional interface that a TagGenerator registered
+// by addons can implement to tell the name used by a field inside the
+// database, which the generated code refers to.
+type DatabaseFieldNamer interface {
+	DatabaseFieldName(name string) string
+}
+
+// TagGeneratorFactory creates the TagGenerator of a field. The field
+// extensions are nil when the tag belongs to a structure created by the
+// plugin, like the ones holding oneofs.
+type TagGeneratorFactory func(defs *extensions.MikrosFieldExtensions) TagGenerator
+
+// databaseFieldNamer is implemented by generators that know the name used by
+// a field inside the database.
+type databaseFieldNamer interface {
+	fieldName(name string) string
+}
+
+// tagGenerators holds the generators registered for database kinds not
+// supported by the plugin.
+var tagGenerators = make(map[string]TagGeneratorFactory)
+
+// messageDatabaseSetter is implemented by generators whose tags depend on the
+// database options of the field message.
+type messageDatabaseSetter interface {
+	setMessageDatabase(field *protobuf.Field, domainName string, db *extensions.MessageDatabaseExtensions)
+}
+
 // NewTagGenerator returns the appropriate generator based on the configuration.
 func NewTagGenerator(kind string, defs *extensions.MikrosFieldExtensions) TagGenerator {
 	switch kind {
@@ -17,11 +49,59 @@ func NewTagGenerator(kind string, defs *extensions.MikrosFieldExtensions) TagGen
 		return &mongoGenerator{defs: defs}
 	case "gorm":
 		return &gormGenerator{defs: defs}
+	case "sqlx":
+		return &sqlxGenerator{defs: defs}
+	case "dynamodb":
+		return &dynamodbGenerator{defs: defs}
+	}
+
+	if factory, ok := tagGenerators[kind]; ok {
+		return factory(defs)
+	}
+
+	return &noopGenerator{}
+}
+
+// RegisterTagGenerator registers the TagGenerator of a new database kind,
+// which can then be used as the settings database kind.
+func RegisterTagGenerator(kind string, factory TagGeneratorFactory) error {
+	if kind == "" || factory == nil {
+		return fmt.Errorf("invalid tag generator for database kind '%s'", kind)
+	}
+	if _, ok := NewTagGenerator(kind, nil).(*noopGenerator); !ok {
+		return fmt.Errorf("database kind '%s' already has a tag generator", kind)
+	}
+
+	tagGenerators[kind] = factory
+	return nil
+}
+
+func databaseFieldName(db TagGenerator, name string) string {
+	switch namer := db.(type) {
+	case databaseFieldNamer:
+		return namer.fieldName(name)
+	case DatabaseFieldNamer:
+		return namer.DatabaseFieldName(name)
 	default:
-		return &noopGenerator{}
+		return ""
 	}
 }
 
+// DatabaseKind returns the database kind used by a message. The message
+// database options take precedence over the settings, so a package can
+// mix different stores.
+func DatabaseKind(message *protobuf.Message, cfg *settings.Settings) string {
+	if kind := loadMessageExtensions(message).GetDatabase().GetKind(); kind != "" {
+		return kind
+	}
+
+	if cfg != nil && cfg.Database != nil {
+		return cfg.Database.Kind
+	}
+
+	return ""
+}
+
 // noopGenerator is a generator that does nothing used when no database
 // is specified.
 type noopGenerator struct{}
@@ -0,0 +1,32 @@
+package mapping
+
+import (
+	"fmt"
+
+	"github.com/stoewer/go-strcase"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+type dynamodbGenerator struct {
+	defs *extensions.MikrosFieldExtensions
+}
+
+func (g *dynamodbGenerator) fieldName(name string) string {
+	if n := g.defs.GetDatabase().GetName(); n != "" {
+		return n
+	}
+
+	return strcase.SnakeCase(name)
+}
+
+// GenerateTag generates a struct tag for the given field name. Keys are
+// never omitted, since DynamoDB requires them in every item.
+func (g *dynamodbGenerator) GenerateTag(name string) string {
+	omitempty := ",omitempty"
+	if db := g.defs.GetDatabase(); db.GetAllowEmpty() || db.GetPartitionKey() || db.GetSortKey() {
+		omitempty = ""
+	}
+
+	return fmt.Sprintf(`dynamodbav:"%s%s"`, g.fieldName(name), omitempty)
+}
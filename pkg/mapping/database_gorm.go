@@ -2,28 +2,75 @@ package mapping
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/stoewer/go-strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
 )
 
 type gormGenerator struct {
-	defs *extensions.MikrosFieldExtensions
+	defs      *extensions.MikrosFieldExtensions
+	field     *protobuf.Field
+	tableName string
+	message   *extensions.MessageDatabaseExtensions
+}
+
+func (g *gormGenerator) fieldName(name string) string {
+	if n := g.defs.GetDatabase().GetName(); n != "" {
+		return n
+	}
+
+	return strcase.SnakeCase(name)
+}
+
+func (g *gormGenerator) setMessageDatabase(
+	field *protobuf.Field,
+	domainName string,
+	db *extensions.MessageDatabaseExtensions,
+) {
+	g.field = field
+	g.tableName = GormTableName(domainName, db)
+	g.message = db
 }
 
 // GenerateTag generates a struct tag for the given field name.
 func (g *gormGenerator) GenerateTag(_ string) string {
-	if g.defs == nil || g.defs.GetDatabase() == nil {
+	var (
+		settings []string
+		db       = g.defs.GetDatabase()
+	)
+
+	if g.field != nil && !g.field.IsOneof() && !hasGormMapping(db) && isGormUnmappable(g.field) {
+		// gorm has nothing to map these fields into, so it must ignore them.
+		return `gorm:"-"`
+	}
+	if db != nil {
+		settings = buildGormFieldSettings(db)
+	}
+	if r := db.GetRelation(); r != nil && g.field != nil {
+		settings = append(settings, buildGormRelationSettings(g.field, r)...)
+	}
+	if g.message != nil {
+		settings = append(settings, g.buildGormMessageSettings(settings)...)
+	}
+
+	if len(settings) == 0 {
 		return ""
 	}
 
-	db := g.defs.GetDatabase()
-	return buildGormTag(db)
+	return fmt.Sprintf(`gorm:"%s"`, strings.Join(settings, ";"))
 }
 
-func buildGormTag(db *extensions.FieldDatabaseOptions) string {
+func buildGormFieldSettings(db *extensions.FieldDatabaseOptions) []string {
 	var (
-		tag   string
-		flags = []struct {
+		settings []string
+		flags    = []struct {
 			Condition bool
 			FlagName  string
 		}{
@@ -51,21 +98,332 @@ func buildGormTag(db *extensions.FieldDatabaseOptions) string {
 	)
 
 	if n := db.GetName(); n != "" {
-		tag += "column=" + n
+		settings = append(settings, "column:"+n)
+	}
+	if t := gormColumnType(db); t != "" {
+		settings = append(settings, "type:"+t)
+	}
+	if size := db.GetSize(); size > 0 {
+		settings = append(settings, fmt.Sprintf("size:%d", size))
+	}
+	if v := db.GetDefaultValue(); v != "" {
+		settings = append(settings, "default:"+escapeGormValue(v))
 	}
 
 	for _, flag := range flags {
 		if flag.Condition {
-			if tag != "" {
-				tag += ","
+			settings = append(settings, flag.FlagName)
+		}
+	}
+
+	if db.GetEmbedded() || db.GetEmbeddedPrefix() != "" {
+		settings = append(settings, "embedded")
+	}
+	if p := db.GetEmbeddedPrefix(); p != "" {
+		settings = append(settings, "embeddedPrefix:"+p)
+	}
+	if db.GetSerializer() != extensions.DatabaseSerializer_DATABASE_SERIALIZER_UNSPECIFIED {
+		settings = append(settings, "serializer:json")
+	}
+
+	return settings
+}
+
+// gormColumnType returns the explicit type of the field column, which
+// serialized fields always have.
+func gormColumnType(db *extensions.FieldDatabaseOptions) string {
+	if t := db.GetColumnType(); t != "" {
+		return t
+	}
+
+	switch db.GetSerializer() {
+	case extensions.DatabaseSerializer_DATABASE_SERIALIZER_JSON:
+		return "json"
+	case extensions.DatabaseSerializer_DATABASE_SERIALIZER_JSONB:
+		return "jsonb"
+	default:
+		return ""
+	}
+}
+
+// buildGormRelationSettings returns the settings that point gorm to the
+// fields joining the field message with its related one.
+func buildGormRelationSettings(field *protobuf.Field, relation *extensions.DatabaseRelation) []string {
+	var (
+		settings                           []string
+		foreignKeyFields, referencesFields = gormRelationFields(field, relation)
+	)
+
+	if name, ok := gormFieldName(foreignKeyFields, relation.GetForeignKey()); ok {
+		settings = append(settings, "foreignKey:"+name)
+	}
+	if name, ok := gormFieldName(referencesFields, relation.GetReferences()); ok {
+		settings = append(settings, "references:"+name)
+	}
+
+	return settings
+}
+
+// buildGormMessageSettings returns the settings that the message database
+// options add to the field.
+func (g *gormGenerator) buildGormMessageSettings(fieldSettings []string) []string {
+	var settings []string
+
+	switch g.field.Name {
+	case g.message.GetCreatedAt():
+		settings = append(settings, "autoCreateTime")
+	case g.message.GetUpdatedAt():
+		settings = append(settings, "autoUpdateTime")
+	case g.message.GetSoftDelete():
+		if !slices.Contains(fieldSettings, "index") {
+			settings = append(settings, "index")
+		}
+	}
+
+	for _, idx := range g.message.GetIndex() {
+		for i, f := range idx.GetField() {
+			if f.GetName() != g.field.Name {
+				continue
+			}
+
+			kind := "index"
+			if idx.GetUnique() {
+				kind = "uniqueIndex"
+			}
+
+			setting := fmt.Sprintf("%s:%s,priority:%d", kind, GormIndexName(g.tableName, idx), i+1)
+			if f.GetDescending() {
+				setting += ",sort:desc"
+			}
+			if w := idx.GetWhere(); w != "" {
+				setting += ",where:" + w
 			}
-			tag += flag.FlagName
+			settings = append(settings, setting)
+		}
+	}
+
+	return settings
+}
+
+// gormRelationFields returns the fields where the foreign key and the
+// references of a relation are searched. Belongs to relations hold the
+// foreign key inside the field message, while the other ones hold it inside
+// the related message.
+func gormRelationFields(
+	field *protobuf.Field,
+	relation *extensions.DatabaseRelation,
+) ([]*protogen.Field, []*protogen.Field) {
+	var (
+		fields  = field.Schema.Parent.Fields
+		related = field.Schema.Message.Fields
+	)
+
+	if relation.GetKind() == extensions.DatabaseRelationKind_DATABASE_RELATION_KIND_BELONGS_TO {
+		return fields, related
+	}
+
+	return related, fields
+}
+
+// gormFieldName returns the name of a field inside its domain structure,
+// which is how gorm refers to it.
+func gormFieldName(fields []*protogen.Field, protoName string) (string, bool) {
+	if protoName == "" {
+		return "", false
+	}
+
+	for _, f := range fields {
+		if string(f.Desc.Name()) != protoName {
+			continue
 		}
+
+		ext := extensions.LoadFieldExtensions(protodesc.ToFieldDescriptorProto(f.Desc))
+		if ext == nil {
+			ext = &extensions.MikrosFieldExtensions{}
+		}
+
+		return buildDomainName(f.GoName, ext), true
+	}
+
+	return "", false
+}
+
+// hasGormColumn checks if gorm can map a field into a column by itself.
+func hasGormColumn(field *protobuf.Field) bool {
+	if field.IsArray() || field.IsMap() {
+		return false
+	}
+	if field.IsMessage() {
+		return field.IsTimestamp() || field.IsDuration() || field.IsProtobufWrapper()
+	}
+
+	return true
+}
+
+// isGormUnmappable checks if gorm can map a field neither into a column nor
+// into an association. Other messages and their arrays are left to the gorm
+// association conventions.
+func isGormUnmappable(field *protobuf.Field) bool {
+	switch {
+	case field.IsMap():
+		return true
+	case field.IsWellKnownType():
+		return field.IsArray() || !hasGormColumn(field)
+	case field.IsArray():
+		return !field.IsMessage()
+	default:
+		return false
+	}
+}
+
+// escapeGormValue escapes a setting value inside the struct tag. The tag
+// value is unquoted before gorm reads it, so the backslash that keeps a
+// separator inside the setting must be escaped too. Colons are kept, since
+// gorm splits the setting on its first one only.
+func escapeGormValue(value string) string {
+	return strings.NewReplacer(";", `\\;`, `"`, `\"`).Replace(value)
+}
+
+// hasGormMapping checks if the field options tell gorm how to map a field
+// without a column of its own.
+func hasGormMapping(db *extensions.FieldDatabaseOptions) bool {
+	return len(gormMappings(db)) > 0
+}
+
+func gormMappings(db *extensions.FieldDatabaseOptions) []string {
+	var mappings []string
+	if db.GetEmbedded() || db.GetEmbeddedPrefix() != "" {
+		mappings = append(mappings, "embedded")
+	}
+	if db.GetRelation() != nil {
+		mappings = append(mappings, "relation")
+	}
+	if db.GetSerializer() != extensions.DatabaseSerializer_DATABASE_SERIALIZER_UNSPECIFIED {
+		mappings = append(mappings, "serializer")
+	}
+
+	return mappings
+}
+
+// validateGormOptions checks if the gorm options of a field can be used
+// with its type and its message database kind.
+func validateGormOptions(proto *protobuf.Field, databaseKind string) error {
+	var (
+		db        = loadFieldExtensions(proto).GetDatabase()
+		mappings  = gormMappings(db)
+		hasColumn = db.GetColumnType() != "" || db.GetSize() != 0 || db.GetDefaultValue() != ""
+		isSQLKind = databaseKind == "gorm" || databaseKind == "sqlx"
+	)
+
+	if len(mappings) > 0 && databaseKind != "gorm" {
+		return fmt.Errorf("field '%s' %s option is only supported by the 'gorm' database kind", proto.Name, mappings[0])
+	}
+	if hasColumn && !isSQLKind {
+		return fmt.Errorf("field '%s' column options are only supported by SQL database kinds", proto.Name)
+	}
+	if len(mappings) > 1 {
+		return fmt.Errorf("field '%s' cannot have both %s and %s options", proto.Name, mappings[0], mappings[1])
+	}
+	if len(mappings) > 0 && proto.IsOneof() {
+		return fmt.Errorf("field '%s' is a oneof member and cannot have the %s option", proto.Name, mappings[0])
+	}
+
+	switch {
+	case db.GetEmbedded() || db.GetEmbeddedPrefix() != "":
+		if !proto.IsMessage() || proto.IsArray() || hasGormColumn(proto) || proto.IsWellKnownType() {
+			return fmt.Errorf("field '%s' must be a singular message to be embedded", proto.Name)
+		}
+	case db.GetRelation() != nil:
+		if err := validateGormRelation(proto, db.GetRelation()); err != nil {
+			return err
+		}
+	case db.GetSerializer() != extensions.DatabaseSerializer_DATABASE_SERIALIZER_UNSPECIFIED:
+		if hasGormColumn(proto) {
+			return fmt.Errorf("field '%s' already has a column and cannot be serialized", proto.Name)
+		}
+	case hasColumn && !hasGormColumn(proto):
+		return fmt.Errorf("field '%s' has no column to have its type, size or default value", proto.Name)
+	}
+
+	if strings.ContainsAny(db.GetDefaultValue(), "`\\\n") {
+		return fmt.Errorf("field '%s' default value cannot have backticks, backslashes or line breaks", proto.Name)
+	}
+	if db.GetSize() < 0 {
+		return fmt.Errorf("field '%s' has an invalid size '%d'", proto.Name, db.GetSize())
+	}
+	if db.GetSize() > 0 && proto.Schema.Desc.Kind() != protoreflect.StringKind {
+		return fmt.Errorf("field '%s' must be a string to have a size", proto.Name)
+	}
+
+	return nil
+}
+
+func validateGormRelation(proto *protobuf.Field, relation *extensions.DatabaseRelation) error {
+	if !proto.IsMessage() || proto.IsWellKnownType() {
+		return fmt.Errorf("field '%s' must be a message to be a relation", proto.Name)
+	}
+
+	switch relation.GetKind() {
+	case extensions.DatabaseRelationKind_DATABASE_RELATION_KIND_HAS_MANY:
+		if !proto.IsArray() {
+			return fmt.Errorf("field '%s' must be repeated to have many relations", proto.Name)
+		}
+	case extensions.DatabaseRelationKind_DATABASE_RELATION_KIND_HAS_ONE,
+		extensions.DatabaseRelationKind_DATABASE_RELATION_KIND_BELONGS_TO:
+		if proto.IsArray() {
+			return fmt.Errorf("field '%s' must not be repeated to have a single relation", proto.Name)
+		}
+	default:
+		return fmt.Errorf("field '%s' relation must have a kind", proto.Name)
+	}
+
+	foreignKeyFields, referencesFields := gormRelationFields(proto, relation)
+	if fk := relation.GetForeignKey(); fk != "" {
+		if _, ok := gormFieldName(foreignKeyFields, fk); !ok {
+			return fmt.Errorf("field '%s' relation foreign key '%s' not found", proto.Name, fk)
+		}
+	}
+	if ref := relation.GetReferences(); ref != "" {
+		if _, ok := gormFieldName(referencesFields, ref); !ok {
+			return fmt.Errorf("field '%s' relation references '%s' not found", proto.Name, ref)
+		}
+	}
+
+	return nil
+}
+
+// GormTableName returns the table name of a domain structure. When the
+// message does not declare it, the gorm default naming strategy for regular
+// nouns is followed.
+func GormTableName(domainName string, db *extensions.MessageDatabaseExtensions) string {
+	if n := db.GetName(); n != "" {
+		return n
+	}
+
+	name := strcase.SnakeCase(domainName)
+
+	switch {
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "z"),
+		strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
+
+// GormIndexName returns the name of an index declared by a message. Unnamed
+// indexes are named after the table and their fields.
+func GormIndexName(table string, idx *extensions.DatabaseIndex) string {
+	if n := idx.GetName(); n != "" {
+		return n
 	}
 
-	if tag != "" {
-		tag = fmt.Sprintf(`gorm:"%s"`, tag)
+	names := []string{"idx", table}
+	for _, f := range idx.GetField() {
+		names = append(names, strcase.SnakeCase(f.GetName()))
 	}
 
-	return tag
+	return strings.Join(names, "_")
 }
@@ -0,0 +1,350 @@
+package mapping
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+// MongoIndexesOptions are the options for building the mongo indexes of a
+// message.
+type MongoIndexesOptions struct {
+	Message *protobuf.Message `validate:"required"`
+
+	// FieldNames maps the proto name of every message field to its name
+	// inside the database.
+	FieldNames map[string]string `validate:"required"`
+	Validate   *validator.Validate
+}
+
+// MongoIndex represents an index of a mongo collection declared by the
+// database options of a message or of its fields.
+type MongoIndex struct {
+	name          string
+	keys          []*mongoIndexKey
+	unique        bool
+	sparse        bool
+	expireAfter   *int32
+	partialFilter string
+}
+
+type mongoIndexKey struct {
+	name       string
+	descending bool
+}
+
+// NewMongoIndexes returns the mongo indexes of a message. Field indexes come
+// first, following the fields order, and compound indexes declared by the
+// message come next.
+func NewMongoIndexes(options *MongoIndexesOptions) ([]*MongoIndex, error) {
+	validate := options.Validate
+	if validate == nil {
+		validate = validator.New()
+	}
+	if err := validate.Struct(options); err != nil {
+		return nil, err
+	}
+
+	var indexes []*MongoIndex
+
+	for _, f := range options.Message.Fields {
+		index, err := newMongoFieldIndex(f, options.FieldNames[f.Name])
+		if err != nil {
+			return nil, err
+		}
+		if index != nil {
+			indexes = append(indexes, index)
+		}
+	}
+
+	for i, idx := range loadMessageExtensions(options.Message).GetDatabase().GetIndex() {
+		index, err := newMongoMessageIndex(i, idx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	return indexes, nil
+}
+
+func newMongoFieldIndex(field *protobuf.Field, name string) (*MongoIndex, error) {
+	db := loadFieldExtensions(field).GetDatabase()
+	if db == nil {
+		return nil, nil
+	}
+
+	var (
+		unique = db.GetUnique() || db.GetUniqueIndex() || (db.GetPrimaryKey() && name != "_id")
+		ttl    = db.GetExpireAfter() != ""
+	)
+
+	if !db.GetIndex() && !unique && !ttl {
+		if db.GetPartialFilter() != "" {
+			return nil, fmt.Errorf("field '%s' has a partial_filter but it is not indexed", field.Name)
+		}
+
+		return nil, nil
+	}
+
+	index := &MongoIndex{
+		keys:   []*mongoIndexKey{{name: name}},
+		unique: unique,
+	}
+
+	if ttl {
+		if !field.IsTimestamp() || field.IsArray() {
+			return nil, fmt.Errorf("field '%s' must be a timestamp to have an expire_after option", field.Name)
+		}
+
+		seconds, err := parseExpireAfter(db.GetExpireAfter())
+		if err != nil {
+			return nil, fmt.Errorf("field '%s' has an invalid expire_after: %w", field.Name, err)
+		}
+		index.expireAfter = &seconds
+	}
+
+	if f := db.GetPartialFilter(); f != "" {
+		filter, err := mongoFilterLiteral(f)
+		if err != nil {
+			return nil, fmt.Errorf("field '%s' has an invalid partial_filter: %w", field.Name, err)
+		}
+		index.partialFilter = filter
+	}
+
+	return index, nil
+}
+
+func newMongoMessageIndex(
+	position int,
+	idx *extensions.DatabaseIndex,
+	options *MongoIndexesOptions,
+) (*MongoIndex, error) {
+	// Unnamed indexes are identified by their position inside errors.
+	indexName := idx.GetName()
+	if indexName == "" {
+		indexName = fmt.Sprintf("#%d", position+1)
+	}
+
+	if len(idx.GetField()) == 0 {
+		return nil, fmt.Errorf("index '%s' of message '%s' has no fields", indexName, options.Message.Name)
+	}
+
+	index := &MongoIndex{
+		name:   idx.GetName(),
+		unique: idx.GetUnique(),
+		sparse: idx.GetSparse(),
+	}
+
+	for _, f := range idx.GetField() {
+		name, ok := options.FieldNames[f.GetName()]
+		if !ok {
+			return nil, fmt.Errorf(
+				"field '%s' declared in index '%s' not found inside message '%s'",
+				f.GetName(),
+				indexName,
+				options.Message.Name,
+			)
+		}
+
+		index.keys = append(index.keys, &mongoIndexKey{
+			name:       name,
+			descending: f.GetDescending(),
+		})
+	}
+
+	if e := idx.GetExpireAfter(); e != "" {
+		if len(index.keys) > 1 {
+			return nil, fmt.Errorf("index '%s' must have a single field to have an expire_after option", indexName)
+		}
+
+		seconds, err := parseExpireAfter(e)
+		if err != nil {
+			return nil, fmt.Errorf("index '%s' has an invalid expire_after: %w", indexName, err)
+		}
+		index.expireAfter = &seconds
+	}
+
+	if f := idx.GetPartialFilter(); f != "" {
+		filter, err := mongoFilterLiteral(f)
+		if err != nil {
+			return nil, fmt.Errorf("index '%s' has an invalid partial_filter: %w", indexName, err)
+		}
+		index.partialFilter = filter
+	}
+
+	return index, nil
+}
+
+// parseExpireAfter converts a TTL duration into seconds, the unit that mongo
+// uses for its TTL indexes.
+func parseExpireAfter(s string) (int32, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+
+	seconds := d / time.Second
+	if seconds < 0 || seconds > math.MaxInt32 {
+		return 0, fmt.Errorf("duration '%s' out of range", s)
+	}
+
+	return int32(seconds), nil
+}
+
+// Keys returns the bson document with the index keys.
+func (m *MongoIndex) Keys() string {
+	keys := make([]string, len(m.keys))
+	for i, k := range m.keys {
+		order := 1
+		if k.descending {
+			order = -1
+		}
+
+		keys[i] = fmt.Sprintf("{Key: %q, Value: %d}", k.name, order)
+	}
+
+	return fmt.Sprintf("bson.D{%s}", strings.Join(keys, ", "))
+}
+
+// Options returns the index options call, or an empty string if the index
+// uses the default options.
+func (m *MongoIndex) Options() string {
+	var calls []string
+
+	if m.name != "" {
+		calls = append(calls, fmt.Sprintf("SetName(%q)", m.name))
+	}
+	if m.unique {
+		calls = append(calls, "SetUnique(true)")
+	}
+	if m.sparse {
+		calls = append(calls, "SetSparse(true)")
+	}
+	if m.expireAfter != nil {
+		calls = append(calls, fmt.Sprintf("SetExpireAfterSeconds(%d)", *m.expireAfter))
+	}
+	if m.partialFilter != "" {
+		calls = append(calls, fmt.Sprintf("SetPartialFilterExpression(%s)", m.partialFilter))
+	}
+
+	if len(calls) == 0 {
+		return ""
+	}
+
+	return "options.Index()." + strings.Join(calls, ".")
+}
+
+// mongoFilterLiteral translates a JSON filter document into the bson
+// expression that represents it, keeping the order of its keys.
+func mongoFilterLiteral(filter string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(filter))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return "", err
+	}
+	if d, ok := token.(json.Delim); !ok || d != '{' {
+		return "", errors.New("filter must be a JSON object")
+	}
+
+	literal, err := mongoJSONValueLiteral(decoder, token)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return "", errors.New("unexpected content after the filter object")
+	}
+
+	return literal, nil
+}
+
+func mongoJSONValueLiteral(decoder *json.Decoder, token json.Token) (string, error) {
+	switch v := token.(type) {
+	case json.Delim:
+		if v == '{' {
+			return mongoJSONObjectLiteral(decoder)
+		}
+		if v == '[' {
+			return mongoJSONArrayLiteral(decoder)
+		}
+		return "", fmt.Errorf("unexpected delimiter '%s'", v)
+	case string:
+		return fmt.Sprintf("%q", v), nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case nil:
+		return "nil", nil
+	default:
+		return "", fmt.Errorf("unsupported value '%v'", v)
+	}
+}
+
+func mongoJSONObjectLiteral(decoder *json.Decoder) (string, error) {
+	var elements []string
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+
+		value, err := mongoJSONValueLiteral(decoder, token)
+		if err != nil {
+			return "", err
+		}
+
+		elements = append(elements, fmt.Sprintf("{Key: %q, Value: %s}", key, value))
+	}
+
+	// Consumes the closing delimiter.
+	if _, err := decoder.Token(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("bson.D{%s}", strings.Join(elements, ", ")), nil
+}
+
+func mongoJSONArrayLiteral(decoder *json.Decoder) (string, error) {
+	var elements []string
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+
+		value, err := mongoJSONValueLiteral(decoder, token)
+		if err != nil {
+			return "", err
+		}
+
+		elements = append(elements, value)
+	}
+
+	// Consumes the closing delimiter.
+	if _, err := decoder.Token(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("bson.A{%s}", strings.Join(elements, ", ")), nil
+}
@@ -0,0 +1,27 @@
+package mapping
+
+import (
+	"fmt"
+
+	"github.com/stoewer/go-strcase"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+type sqlxGenerator struct {
+	defs *extensions.MikrosFieldExtensions
+}
+
+func (g *sqlxGenerator) fieldName(name string) string {
+	if n := g.defs.GetDatabase().GetName(); n != "" {
+		return n
+	}
+
+	return strcase.SnakeCase(name)
+}
+
+// GenerateTag generates a struct tag for the given field name. Columns are
+// always scanned, so allow_empty has no meaning here.
+func (g *sqlxGenerator) GenerateTag(name string) string {
+	return fmt.Sprintf(`db:"%s"`, g.fieldName(name))
+}
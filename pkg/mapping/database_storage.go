@@ -0,0 +1,100 @@
+package mapping
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+// Supported database storage types.
+const (
+	StorageTypeObjectID   = "objectid"
+	StorageTypeDecimal128 = "decimal128"
+	StorageTypeDate       = "date"
+)
+
+// FieldStorageType returns the database storage type of a field, or an empty
+// string if the field is stored with its own type.
+func FieldStorageType(proto *protobuf.Field) string {
+	switch loadFieldExtensions(proto).GetDatabase().GetStorageType() {
+	case extensions.DatabaseStorageType_DATABASE_STORAGE_TYPE_OBJECT_ID:
+		return StorageTypeObjectID
+	case extensions.DatabaseStorageType_DATABASE_STORAGE_TYPE_DECIMAL128:
+		return StorageTypeDecimal128
+	case extensions.DatabaseStorageType_DATABASE_STORAGE_TYPE_DATE:
+		return StorageTypeDate
+	default:
+		return ""
+	}
+}
+
+// validateStorageType checks if the storage type of a field can be used with
+// its type and its message database kind.
+func validateStorageType(proto *protobuf.Field, databaseKind string) error {
+	storageType := FieldStorageType(proto)
+	if storageType == "" {
+		return nil
+	}
+
+	if databaseKind != "mongo" {
+		return fmt.Errorf("field '%s' storage type is only supported by the 'mongo' database kind", proto.Name)
+	}
+	if proto.IsArray() || proto.IsMap() || proto.IsOptional() || proto.IsOneof() {
+		return fmt.Errorf("field '%s' must be a singular non-optional field to have a storage type", proto.Name)
+	}
+
+	kind := proto.Schema.Desc.Kind()
+	if kind == protoreflect.StringKind {
+		return nil
+	}
+	if storageType == StorageTypeDate && kind == protoreflect.Int64Kind {
+		return nil
+	}
+
+	return fmt.Errorf("field '%s' has an unsupported type to be stored as %s", proto.Name, storageType)
+}
+
+// storageDomainType returns the domain type of a field with a storage type.
+func storageDomainType(storageType string) string {
+	switch storageType {
+	case StorageTypeObjectID:
+		return "primitive.ObjectID"
+	case StorageTypeDecimal128:
+		return "primitive.Decimal128"
+	default:
+		return "time.Time"
+	}
+}
+
+// storageToDomain returns the conversion of a wire value into the domain
+// type of its storage type.
+func storageToDomain(proto *protobuf.Field, storageType, receiver string) string {
+	return fmt.Sprintf("toDomain%s(%s)", storageConversionName(proto, storageType), receiver)
+}
+
+// storageToWire returns the conversion of a domain value, held with its
+// storage type, into its wire type.
+func storageToWire(proto *protobuf.Field, storageType, receiver string) string {
+	return fmt.Sprintf("toWire%s(%s)", storageConversionName(proto, storageType), receiver)
+}
+
+// storageConversionName returns the suffix of the helpers that convert the
+// values of a storage type, which are generated along with the wire
+// conversions.
+func storageConversionName(proto *protobuf.Field, storageType string) string {
+	switch storageType {
+	case StorageTypeObjectID:
+		return "ObjectID"
+	case StorageTypeDecimal128:
+		return "Decimal128"
+	}
+
+	if proto.Schema.Desc.Kind() == protoreflect.Int64Kind {
+		return "DateMillis"
+	}
+
+	return "Date"
+}
@@ -57,12 +57,12 @@ func getMapKeyValueTypesForWire(field *protobuf.Field) (string, string, protoref
 		value = ProtoTypeToGoType(v.Kind(), "", "")
 	)
 
+	if t, ok := descriptorWellKnownType(v.Message()); ok {
+		return ProtoKindToGoType(field.Schema.Desc.MapKey().Kind()), "*" + t.wire, v
+	}
+
 	if v.Kind() == protoreflect.MessageKind {
 		name := string(v.Message().Name())
-		if name == "Timestamp" {
-			name = "ts.Timestamp"
-		}
-
 		parts := strings.Split(string(v.Message().FullName()), ".")
 		value = "*" + name
 		if parts[1] != field.ModuleName() {
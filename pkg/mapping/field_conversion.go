@@ -2,7 +2,6 @@ package mapping
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -26,6 +25,7 @@ type FieldConversionOptions struct {
 type FieldConversion struct {
 	messageReceiver string
 	goType          string
+	storageType     string
 	proto           *protobuf.Field
 	settings        *settings.Settings
 	extensions      *extensions.MikrosFieldExtensions
@@ -45,6 +45,7 @@ func NewFieldConversion(options *FieldConversionOptions) (*FieldConversion, erro
 	return &FieldConversion{
 		messageReceiver: options.MessageReceiver,
 		goType:          options.FieldType.GoType(),
+		storageType:     FieldStorageType(options.ProtoField),
 		proto:           options.ProtoField,
 		settings:        options.Settings,
 		extensions:      loadFieldExtensions(options.ProtoField),
@@ -55,6 +56,10 @@ func NewFieldConversion(options *FieldConversionOptions) (*FieldConversion, erro
 // ToWireType converts a field's value to its corresponding wire type representation
 // based on its protobuf type and settings.
 func (f *FieldConversion) ToWireType(wireInput bool) string {
+	if f.storageType != "" {
+		return storageToWire(f.proto, f.storageType, f.domainReceiver())
+	}
+
 	if f.proto.IsEnum() {
 		return f.enumWireType()
 	}
@@ -74,6 +79,10 @@ func (f *FieldConversion) ToWireType(wireInput bool) string {
 		return fmt.Sprintf("%s(%s.%s)", call, f.messageReceiver, f.naming.Domain())
 	}
 
+	if c, ok := f.wellKnownToWireType(f.messageName(), f.domainReceiver()); ok {
+		return c
+	}
+
 	if f.proto.IsMessage() {
 		if wireInput {
 			return fmt.Sprintf("%s.%s.IntoWireInput()", f.messageReceiver, f.naming.Domain())
@@ -87,23 +96,10 @@ func (f *FieldConversion) ToWireType(wireInput bool) string {
 
 func (f *FieldConversion) enumWireType() string {
 	var (
-		name   = TrimPackageName(f.goType, f.proto.ModuleName())
-		prefix string
+		name = f.enumTypeName()
+		arg  = fmt.Sprintf("%s.%s", f.messageReceiver, f.naming.GoName())
 	)
 
-	// If the enum is from another package, we need to add the module name
-	// as its prefix.
-	module, n, ok := handleOtherModuleField(f.goType, f.proto)
-	if ok {
-		prefix = ""
-		if module != f.proto.ModuleName() {
-			prefix = fmt.Sprintf("%s.", module)
-		}
-
-		name = fmt.Sprintf("%s%s", prefix, n)
-	}
-
-	arg := fmt.Sprintf("%s.%s", f.messageReceiver, f.naming.GoName())
 	if f.proto.IsOptional() {
 		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToValue)
 		arg = fmt.Sprintf("%s(%s)", call, arg)
@@ -118,9 +114,30 @@ func (f *FieldConversion) enumWireType() string {
 	return conversionCall
 }
 
+// enumTypeName returns the wire enum type name, with its module as prefix
+// when the enum is declared inside another package.
+func (f *FieldConversion) enumTypeName() string {
+	name := TrimPackageName(f.goType, f.proto.ModuleName())
+
+	if module, n, ok := handleOtherModuleField(f.goType, f.proto); ok {
+		prefix := ""
+		if module != f.proto.ModuleName() {
+			prefix = fmt.Sprintf("%s.", module)
+		}
+
+		name = fmt.Sprintf("%s%s", prefix, n)
+	}
+
+	return name
+}
+
 // DomainTypeToWireType converts a domain-specific type into its corresponding
 // wire format type representation.
 func (f *FieldConversion) DomainTypeToWireType() string {
+	if f.storageType != "" {
+		return storageToDomain(f.proto, f.storageType, f.domainReceiver())
+	}
+
 	if f.proto.IsEnum() {
 		call := fmt.Sprintf("%s.%s.ValueWithoutPrefix()", f.messageReceiver, f.naming.Domain())
 		if f.proto.IsOptional() {
@@ -142,6 +159,10 @@ func (f *FieldConversion) DomainTypeToWireType() string {
 		return fmt.Sprintf("toDomainMap(%s.%s)", f.messageReceiver, f.naming.Domain())
 	}
 
+	if c, ok := f.wellKnownToDomainType(f.messageName(), f.domainReceiver()); ok {
+		return c
+	}
+
 	if f.proto.IsMessage() {
 		return fmt.Sprintf("%s.%s.IntoDomain()", f.messageReceiver, f.naming.Domain())
 	}
@@ -166,9 +187,8 @@ func (f *FieldConversion) DomainTypeToArrayWireType(receiver string, wireInput b
 		return fmt.Sprintf("%s.FromString(0, %s)", name, receiver)
 	}
 
-	if f.proto.IsTimestamp() {
-		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallTimeToProto)
-		return fmt.Sprintf("%s(%s)", call, receiver)
+	if c, ok := f.wellKnownToWireType(f.messageName(), receiver); ok {
+		return c
 	}
 
 	if f.proto.IsMessage() {
@@ -189,8 +209,8 @@ func (f *FieldConversion) WireTypeToArrayDomainType(receiver string) string {
 		return fmt.Sprintf("%s.ValueWithoutPrefix()", receiver)
 	}
 
-	if f.proto.IsTimestamp() {
-		return fmt.Sprintf("toDomainTime(%s)", receiver)
+	if c, ok := f.wellKnownToDomainType(f.messageName(), receiver); ok {
+		return c
 	}
 
 	if f.proto.IsMessage() {
@@ -210,9 +230,8 @@ func (f *FieldConversion) DomainTypeToMapWireType(receiver string, wireInput boo
 	}
 
 	if valueKind.Kind() == protoreflect.MessageKind {
-		if strings.Contains(value, "ts.Timestamp") {
-			call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallTimeToProto)
-			return fmt.Sprintf("%s(%s)", call, receiver)
+		if c, ok := f.wellKnownToWireType(valueKind.Message().FullName(), receiver); ok {
+			return c
 		}
 
 		if wireInput {
@@ -228,15 +247,15 @@ func (f *FieldConversion) DomainTypeToMapWireType(receiver string, wireInput boo
 // WireTypeToMapDomainType converts a wire type representation to its corresponding
 // domain type representation.
 func (f *FieldConversion) WireTypeToMapDomainType(receiver string) string {
-	_, value, valueKind := getMapKeyValueTypesForWire(f.proto)
+	_, _, valueKind := getMapKeyValueTypesForWire(f.proto)
 
 	if valueKind.Kind() == protoreflect.EnumKind {
 		return fmt.Sprintf("%v.ValueWithoutPrefix()", receiver)
 	}
 
 	if valueKind.Kind() == protoreflect.MessageKind {
-		if strings.Contains(value, "ts.Timestamp") {
-			return fmt.Sprintf("toDomainTime(%s)", receiver)
+		if c, ok := f.wellKnownToDomainType(valueKind.Message().FullName(), receiver); ok {
+			return c
 		}
 
 		return fmt.Sprintf("%s.IntoDomain()", receiver)
@@ -260,6 +279,10 @@ func (f *FieldConversion) WireOutputToOutbound(receiver string) string {
 		}
 	}
 
+	if c, ok := bytesEncodeCall(f.bytesEncoding(), fmt.Sprintf("%s.%s", receiver, f.naming.GoName())); ok {
+		return c
+	}
+
 	if f.proto.IsEnum() {
 		conversionCall := fmt.Sprintf("%s.%s.ValueWithoutPrefix()", receiver, f.naming.GoName())
 
@@ -284,6 +307,10 @@ func (f *FieldConversion) WireOutputToOutbound(receiver string) string {
 		return fmt.Sprintf("%s.%s.AsMap()", receiver, f.naming.GoName())
 	}
 
+	if c, ok := f.wellKnownToOutboundType(f.messageName(), fmt.Sprintf("%s.%s", receiver, f.naming.GoName())); ok {
+		return c
+	}
+
 	if f.proto.IsMessage() {
 		return fmt.Sprintf("%s.%s.IntoOutboundOrNil()", receiver, f.naming.GoName())
 	}
@@ -296,11 +323,19 @@ func (f *FieldConversion) WireOutputToOutbound(receiver string) string {
 func (f *FieldConversion) WireOutputToMapOutbound(receiver string) string {
 	v := f.proto.Schema.Desc.MapValue()
 
+	if c, ok := bytesEncodeCall(f.bytesEncoding(), receiver); ok {
+		return c
+	}
+
 	if v.Kind() == protoreflect.EnumKind {
 		return fmt.Sprintf("%s.ValueWithoutPrefix()", receiver)
 	}
 
 	if v.Kind() == protoreflect.MessageKind {
+		if c, ok := f.wellKnownToOutboundType(v.Message().FullName(), receiver); ok {
+			return c
+		}
+
 		return fmt.Sprintf("%s.IntoOutboundOrNil()", receiver)
 	}
 
@@ -310,6 +345,10 @@ func (f *FieldConversion) WireOutputToMapOutbound(receiver string) string {
 // WireOutputToArrayOutbound converts the field's value from its internal representation
 // to an outbound-friendly format for array fields.
 func (f *FieldConversion) WireOutputToArrayOutbound(receiver string) string {
+	if c, ok := bytesEncodeCall(f.bytesEncoding(), receiver); ok {
+		return c
+	}
+
 	if f.proto.IsEnum() {
 		return fmt.Sprintf("%s.ValueWithoutPrefix()", receiver)
 	}
@@ -323,9 +362,239 @@ func (f *FieldConversion) WireOutputToArrayOutbound(receiver string) string {
 		return fmt.Sprintf("%s.AsMap()", receiver)
 	}
 
+	if c, ok := f.wellKnownToOutboundType(f.messageName(), receiver); ok {
+		return c
+	}
+
 	if f.proto.IsMessage() {
 		return fmt.Sprintf("%s.IntoOutboundOrNil()", receiver)
 	}
 
 	return receiver
 }
+
+// DomainTypeToOneofWireType converts the domain value of a oneof member,
+// which is always a pointer, into the value that must be set inside its wire
+// oneof wrapper.
+func (f *FieldConversion) DomainTypeToOneofWireType(receiver string, wireInput bool) string {
+	if f.proto.IsEnum() {
+		return fmt.Sprintf("%[1]s.FromString(%[1]s(0), *%s)", f.enumTypeName(), receiver)
+	}
+
+	if f.proto.IsProtoValue() {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToProtoValue)
+		return fmt.Sprintf("%s(%s)", call, receiver)
+	}
+
+	if f.proto.IsTimestamp() {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallTimeToProto)
+		return fmt.Sprintf("%s(%s)", call, receiver)
+	}
+
+	if f.proto.IsProtoStruct() {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallMapToStruct)
+		return fmt.Sprintf("%s(%s)", call, receiver)
+	}
+
+	if c, ok := f.wellKnownToWireType(f.messageName(), receiver); ok {
+		return c
+	}
+
+	if f.proto.IsMessage() {
+		if wireInput {
+			return fmt.Sprintf("%s.IntoWireInput()", receiver)
+		}
+
+		return fmt.Sprintf("%s.IntoWire()", receiver)
+	}
+
+	return "*" + receiver
+}
+
+// WireTypeToOneofDomainType converts the value of a oneof member, taken from
+// its wire oneof wrapper, into its domain representation.
+func (f *FieldConversion) WireTypeToOneofDomainType(receiver string) string {
+	if f.proto.IsEnum() {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+		return fmt.Sprintf("%s(%s.ValueWithoutPrefix())", call, receiver)
+	}
+
+	if f.proto.IsProtoValue() {
+		return fmt.Sprintf("toDomainInterface(%s)", receiver)
+	}
+
+	if f.proto.IsTimestamp() {
+		return fmt.Sprintf("toDomainTime(%s)", receiver)
+	}
+
+	if f.proto.IsProtoStruct() {
+		return fmt.Sprintf("toDomainMap(%s)", receiver)
+	}
+
+	if c, ok := f.wellKnownToDomainType(f.messageName(), receiver); ok {
+		return c
+	}
+
+	if f.proto.IsMessage() {
+		return fmt.Sprintf("%s.IntoDomain()", receiver)
+	}
+
+	call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+	return fmt.Sprintf("%s(%s)", call, receiver)
+}
+
+// WireOutputToOneofOutbound converts the value of a oneof member, taken from
+// its wire oneof wrapper, into its outbound representation.
+func (f *FieldConversion) WireOutputToOneofOutbound(receiver string) string {
+	if f.proto.IsEnum() {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+		return fmt.Sprintf("%s(%s.ValueWithoutPrefix())", call, receiver)
+	}
+
+	if c, ok := bytesEncodeCall(f.bytesEncoding(), receiver); ok {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+		return fmt.Sprintf("%s(%s)", call, c)
+	}
+
+	if f.proto.IsProtoValue() {
+		return fmt.Sprintf("%s.AsInterface()", receiver)
+	}
+
+	if f.proto.IsTimestamp() {
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallProtoToTimePtr)
+		return fmt.Sprintf("%s(%s)", call, receiver)
+	}
+
+	if f.proto.IsProtoStruct() {
+		return fmt.Sprintf("%s.AsMap()", receiver)
+	}
+
+	if c, ok := f.wellKnownToOutboundType(f.messageName(), receiver); ok {
+		return c
+	}
+
+	if f.proto.IsMessage() {
+		return fmt.Sprintf("%s.IntoOutboundOrNil()", receiver)
+	}
+
+	call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallToPtr)
+	return fmt.Sprintf("%s(%s)", call, receiver)
+}
+
+// BytesDecoder returns the function that decodes string arguments of bytes
+// fields with an outbound encoding. It returns an empty string when the field
+// doesn't need one.
+func (f *FieldConversion) BytesDecoder() string {
+	return bytesDecoder(f.bytesEncoding())
+}
+
+// IsRawBytes returns true if the field holds bytes that must be handled
+// without any encoding.
+func (f *FieldConversion) IsRawBytes() bool {
+	return f.bytesEncoding() == extensions.BytesEncoding_BYTES_ENCODING_RAW
+}
+
+func (f *FieldConversion) bytesEncoding() extensions.BytesEncoding {
+	return fieldBytesEncoding(f.proto, f.extensions)
+}
+
+func (f *FieldConversion) domainReceiver() string {
+	return fmt.Sprintf("%s.%s", f.messageReceiver, f.naming.Domain())
+}
+
+// messageName returns the full name of the field message type, or an empty
+// name if the field is not a message.
+func (f *FieldConversion) messageName() protoreflect.FullName {
+	if f.proto.IsMessage() {
+		return f.proto.Schema.Desc.Message().FullName()
+	}
+
+	return ""
+}
+
+// wellKnownToWireType converts the domain value of a google.protobuf
+// well-known type into its wire type.
+func (f *FieldConversion) wellKnownToWireType(name protoreflect.FullName, receiver string) (string, bool) {
+	t, ok := wellKnownTypes[name]
+	if !ok {
+		return "", false
+	}
+
+	var call settings.CommonCall
+	switch name {
+	case timestampTypeName:
+		call = settings.CommonCallTimeToProto
+	case durationTypeName:
+		call = settings.CommonCallDurationToProto
+	case fieldMaskTypeName:
+		call = settings.CommonCallPathsToFieldMask
+	case structTypeName:
+		call = settings.CommonCallMapToStruct
+	case valueTypeName:
+		call = settings.CommonCallToProtoValue
+	case anyTypeName:
+		call = settings.CommonCallMessageToProtoAny
+	case bytesValueTypeName:
+		call = settings.CommonCallBytesToProtoWrapper
+	default:
+		c := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallPtrToProtoWrapper)
+		return fmt.Sprintf("%s(%s, wrapperspb.%s)", c, receiver, t.wrapper), true
+	}
+
+	return fmt.Sprintf("%s(%s)", f.settings.GetCommonCall(settings.CommonAPIConverters, call), receiver), true
+}
+
+// wellKnownToDomainType converts the wire value of a google.protobuf
+// well-known type into its domain type.
+func (f *FieldConversion) wellKnownToDomainType(name protoreflect.FullName, receiver string) (string, bool) {
+	switch name {
+	case timestampTypeName:
+		return fmt.Sprintf("toDomainTime(%s)", receiver), true
+	case structTypeName:
+		return fmt.Sprintf("toDomainMap(%s)", receiver), true
+	case valueTypeName:
+		return fmt.Sprintf("toDomainInterface(%s)", receiver), true
+	}
+
+	return f.wellKnownFromWireType(name, receiver)
+}
+
+// wellKnownToOutboundType converts the wire value of a google.protobuf
+// well-known type into its outbound type.
+func (f *FieldConversion) wellKnownToOutboundType(name protoreflect.FullName, receiver string) (string, bool) {
+	switch name {
+	case timestampTypeName:
+		call := f.settings.GetCommonCall(settings.CommonAPIConverters, settings.CommonCallProtoToTimePtr)
+		return fmt.Sprintf("%s(%s)", call, receiver), true
+	case structTypeName:
+		return fmt.Sprintf("%s.AsMap()", receiver), true
+	case valueTypeName:
+		return fmt.Sprintf("%s.AsInterface()", receiver), true
+	}
+
+	return f.wellKnownFromWireType(name, receiver)
+}
+
+// wellKnownFromWireType holds the wire conversions that are shared by domain
+// and outbound types.
+func (f *FieldConversion) wellKnownFromWireType(name protoreflect.FullName, receiver string) (string, bool) {
+	if _, ok := wellKnownTypes[name]; !ok {
+		return "", false
+	}
+
+	var call settings.CommonCall
+	switch name {
+	case fieldMaskTypeName:
+		return fmt.Sprintf("%s.GetPaths()", receiver), true
+	case bytesValueTypeName:
+		return fmt.Sprintf("%s.GetValue()", receiver), true
+	case durationTypeName:
+		call = settings.CommonCallProtoToDurationPtr
+	case anyTypeName:
+		call = settings.CommonCallProtoAnyToMessage
+	default:
+		call = settings.CommonCallProtoWrapperToPtr
+	}
+
+	return fmt.Sprintf("%s(%s)", f.settings.GetCommonCall(settings.CommonAPIConverters, call), receiver), true
+}
@@ -0,0 +1,112 @@
+package mapping
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+)
+
+// DomainDefaultValue returns the Go literal of the default value declared for
+// a field inside its domain structure, or an empty string if the field has
+// none.
+func DomainDefaultValue(proto *protobuf.Field) (string, error) {
+	value := loadFieldExtensions(proto).GetDomain().GetDefaultValue()
+	if value == "" {
+		return "", nil
+	}
+
+	if proto.IsArray() || proto.IsMap() || proto.IsOneof() || FieldStorageType(proto) != "" {
+		return "", fmt.Errorf("field '%s' must be a singular scalar field to have a default value", proto.Name)
+	}
+
+	field := proto.Schema.Desc
+	if proto.IsProtobufWrapper() {
+		field = field.Message().Fields().ByName("value")
+	}
+
+	literal, err := defaultValueLiteral(field, value)
+	if err != nil {
+		// Only the reason of strconv errors matters, the value is already
+		// part of the message.
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+
+		return "", fmt.Errorf("field '%s' has an invalid default value '%s': %w", proto.Name, value, err)
+	}
+
+	return literal, nil
+}
+
+func defaultValueLiteral(field protoreflect.FieldDescriptor, value string) (string, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return strconv.Quote(value), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return parseIntLiteral(value, 32)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return parseIntLiteral(value, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return parseUintLiteral(value, 32)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return parseUintLiteral(value, 64)
+	case protoreflect.FloatKind:
+		return parseFloatLiteral(value, 32)
+	case protoreflect.DoubleKind:
+		return parseFloatLiteral(value, 64)
+	case protoreflect.EnumKind:
+		// Domain structures hold enums by their names without prefix.
+		enum := field.Enum()
+		if enum.Values().ByName(protoreflect.Name(value)) == nil {
+			return "", fmt.Errorf("not a value of enum '%s'", enum.Name())
+		}
+
+		return strconv.Quote(strings.TrimPrefix(value, protobuf.EnumPrefix(string(enum.Name())))), nil
+	default:
+		return "", errors.New("unsupported field type")
+	}
+}
+
+func parseIntLiteral(value string, bitSize int) (string, error) {
+	i, err := strconv.ParseInt(value, 10, bitSize)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(i, 10), nil
+}
+
+func parseUintLiteral(value string, bitSize int) (string, error) {
+	u, err := strconv.ParseUint(value, 10, bitSize)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(u, 10), nil
+}
+
+func parseFloatLiteral(value string, bitSize int) (string, error) {
+	f, err := strconv.ParseFloat(value, bitSize)
+	if err != nil {
+		return "", err
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", errors.New("not a finite number")
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, bitSize), nil
+}
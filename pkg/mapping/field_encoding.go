@@ -0,0 +1,61 @@
+package mapping
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+)
+
+// IsBytesField checks if the field holds bytes values, i.e., if it is a bytes
+// field, an array of bytes or a map with bytes values.
+func IsBytesField(field *protobuf.Field) bool {
+	if field.IsMap() {
+		return field.MapValueTypeKind() == protoreflect.BytesKind
+	}
+
+	return field.Schema.Desc.Kind() == protoreflect.BytesKind
+}
+
+// fieldBytesEncoding returns the outbound encoding of a bytes field, or
+// BYTES_ENCODING_UNSPECIFIED if the field doesn't set one.
+func fieldBytesEncoding(field *protobuf.Field, ext *extensions.MikrosFieldExtensions) extensions.BytesEncoding {
+	if !IsBytesField(field) {
+		return extensions.BytesEncoding_BYTES_ENCODING_UNSPECIFIED
+	}
+
+	return ext.GetOutbound().GetBytesEncoding()
+}
+
+// bytesEncodeCall returns the call that encodes a bytes value into a string
+// using an encoding. Raw bytes are only received by requests, since JSON
+// strings can't hold them inside outbound structures.
+func bytesEncodeCall(encoding extensions.BytesEncoding, receiver string) (string, bool) {
+	switch encoding {
+	case extensions.BytesEncoding_BYTES_ENCODING_BASE64:
+		return fmt.Sprintf("base64.StdEncoding.EncodeToString(%s)", receiver), true
+	case extensions.BytesEncoding_BYTES_ENCODING_BASE64_URL:
+		return fmt.Sprintf("base64.URLEncoding.EncodeToString(%s)", receiver), true
+	case extensions.BytesEncoding_BYTES_ENCODING_HEX:
+		return fmt.Sprintf("hex.EncodeToString(%s)", receiver), true
+	default:
+		return "", false
+	}
+}
+
+// bytesDecoder returns the function that decodes a string into bytes using
+// an encoding. Raw and unspecified encodings don't have one.
+func bytesDecoder(encoding extensions.BytesEncoding) string {
+	switch encoding {
+	case extensions.BytesEncoding_BYTES_ENCODING_BASE64:
+		return "base64.StdEncoding.DecodeString"
+	case extensions.BytesEncoding_BYTES_ENCODING_BASE64_URL:
+		return "base64.URLEncoding.DecodeString"
+	case extensions.BytesEncoding_BYTES_ENCODING_HEX:
+		return "hex.DecodeString"
+	default:
+		return ""
+	}
+}
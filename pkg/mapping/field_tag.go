@@ -23,6 +23,7 @@ type FieldTag struct {
 	outboundTag       string
 	outboundFieldName string
 	inboundTag        string
+	databaseName      string
 }
 
 // NewFieldTag returns a new FieldTag instance.
@@ -35,19 +36,19 @@ func NewFieldTag(options *FieldTagOptions) (*FieldTag, error) {
 		return nil, err
 	}
 
-	var databaseKind string
-	if options.Settings != nil {
-		databaseKind = options.Settings.Database.Kind
-	}
-
 	var (
 		fieldExtensions   = loadFieldExtensions(options.ProtoField)
 		messageExtensions = loadMessageExtensions(options.ProtoMessage)
+		databaseKind      = DatabaseKind(options.ProtoMessage, options.Settings)
 		db                = NewTagGenerator(databaseKind, fieldExtensions)
 		domainNameMode    = extensions.NamingMode_NAMING_MODE_SNAKE_CASE
 		outboundNameMode  = extensions.NamingMode_NAMING_MODE_SNAKE_CASE
 	)
 
+	if err := validateGormOptions(options.ProtoField, databaseKind); err != nil {
+		return nil, err
+	}
+
 	if messageDomain := messageExtensions.GetDomain(); messageDomain != nil {
 		domainNameMode = messageDomain.GetNamingMode()
 	}
@@ -58,13 +59,22 @@ func NewFieldTag(options *FieldTagOptions) (*FieldTag, error) {
 	var (
 		domainName   = resolveNameForTag(options.FieldNaming.Domain(), domainNameMode)
 		outboundName = resolveNameForTag(options.FieldNaming.Outbound(), outboundNameMode)
+		databaseName = databaseFieldName(db, domainName)
 	)
+	if setter, ok := db.(messageDatabaseSetter); ok {
+		setter.setMessageDatabase(
+			options.ProtoField,
+			NewMessage(MessageOptions{Settings: options.Settings}).WireToDomain(options.ProtoMessage.Name),
+			messageExtensions.GetDatabase(),
+		)
+	}
 
 	return &FieldTag{
 		domainTag:         buildDomainTag(domainName, fieldExtensions, db),
 		outboundTag:       buildOutboundTag(outboundName, fieldExtensions),
 		outboundFieldName: outboundName,
 		inboundTag:        buildInboundTag(options.FieldNaming.Inbound()),
+		databaseName:      databaseName,
 	}, nil
 }
 
@@ -170,3 +180,9 @@ func (f *FieldTag) OutboundTagFieldName() string {
 func (f *FieldTag) Inbound() string {
 	return f.inboundTag
 }
+
+// DatabaseName returns the name of the field inside the database, when the
+// database kind knows it.
+func (f *FieldTag) DatabaseName() string {
+	return f.databaseName
+}
@@ -27,11 +27,12 @@ type FieldTypeOptions struct {
 // FieldType is the mechanism that allows getting the Field type for
 // different scenarios.
 type FieldType struct {
-	isArray    bool
-	goType     string
-	msg        *Message
-	proto      *protobuf.Field
-	extensions *extensions.MikrosFieldExtensions
+	isArray     bool
+	goType      string
+	storageType string
+	msg         *Message
+	proto       *protobuf.Field
+	extensions  *extensions.MikrosFieldExtensions
 }
 
 // NewFieldType creates a new FieldType instance.
@@ -44,6 +45,10 @@ func NewFieldType(options *FieldTypeOptions) (*FieldType, error) {
 		return nil, err
 	}
 
+	if err := validateStorageType(options.ProtoField, DatabaseKind(options.ProtoMessage, options.Settings)); err != nil {
+		return nil, err
+	}
+
 	return &FieldType{
 		isArray: options.ProtoField.IsArray(),
 		goType: ProtoTypeToGoType(
@@ -51,9 +56,10 @@ func NewFieldType(options *FieldTypeOptions) (*FieldType, error) {
 			options.ProtoField.Proto.GetTypeName(),
 			options.ProtoMessage.ModuleName,
 		),
-		msg:        options.Message,
-		proto:      options.ProtoField,
-		extensions: loadFieldExtensions(options.ProtoField),
+		storageType: FieldStorageType(options.ProtoField),
+		msg:         options.Message,
+		proto:       options.ProtoField,
+		extensions:  loadFieldExtensions(options.ProtoField),
 	}, nil
 }
 
@@ -69,8 +75,8 @@ func (f *FieldType) Wire(isPointer bool) string {
 		return fmt.Sprintf("map[%s]%s", key, value)
 	}
 
-	if f.proto.IsTimestamp() {
-		return formatType("ts.Timestamp", f.isArray, isPointer)
+	if t, ok := descriptorWellKnownType(f.proto.Schema.Desc.Message()); ok {
+		return formatType(t.wire, f.isArray, isPointer)
 	}
 
 	// Handle fields from other modules
@@ -111,6 +117,11 @@ func (f *FieldType) convertTo(mode conversionMode, isPointer, testMode bool) str
 		}
 	}
 
+	// Domain values are held with their database storage type.
+	if mode == wireToDomain && f.storageType != "" {
+		return storageDomainType(f.storageType)
+	}
+
 	// Handle Built-in Proto Types
 	if t, ok := f.getBuiltInType(isPointer); ok {
 		return t
@@ -148,6 +159,10 @@ func (f *FieldType) getBuiltInType(isPointer bool) (string, bool) {
 		return "interface{}", true
 	}
 
+	if t, ok := descriptorWellKnownType(f.proto.Schema.Desc.Message()); ok {
+		return formatType(t.domain, f.isArray, isPointer && t.pointer), true
+	}
+
 	return "", false
 }
 
@@ -157,6 +172,10 @@ func (f *FieldType) getMapKeyValueTypes(testMode bool, mode conversionMode) (str
 		value = ProtoTypeToGoType(v.Kind(), "", "")
 	)
 
+	if t, ok := descriptorWellKnownType(v.Message()); ok {
+		return ProtoKindToGoType(f.proto.Schema.Desc.MapKey().Kind()), formatType(t.domain, false, t.pointer)
+	}
+
 	if v.Kind() == protoreflect.MessageKind {
 		valueType := f.msg.WireToDomainMapValueType(string(v.Message().Name()))
 		if mode == wireToOutbound {
@@ -175,6 +194,10 @@ func (f *FieldType) getMapKeyValueTypes(testMode bool, mode conversionMode) (str
 		value = "string"
 	}
 
+	if mode == wireToOutbound && f.hasBytesEncoding() {
+		value = "string"
+	}
+
 	return ProtoKindToGoType(f.proto.Schema.Desc.MapKey().Kind()), value
 }
 
@@ -220,5 +243,15 @@ func (f *FieldType) convertFromWireTypeToOutbound() string {
 		}
 	}
 
+	// Bytes fields with an explicit encoding are exported as their encoded
+	// strings.
+	if f.hasBytesEncoding() {
+		return "string"
+	}
+
 	return f.goType
 }
+
+func (f *FieldType) hasBytesEncoding() bool {
+	return fieldBytesEncoding(f.proto, f.extensions) != extensions.BytesEncoding_BYTES_ENCODING_UNSPECIFIED
+}
@@ -3,6 +3,7 @@ package mapping
 import (
 	"fmt"
 
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	"github.com/go-playground/validator/v10"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/internal/validation"
@@ -23,10 +24,13 @@ type FieldValidationOptions struct {
 
 // FieldValidation represents the validation logic for a field.
 type FieldValidation struct {
-	isHTTPService bool
-	validation    *validation.Call
-	naming        *FieldNaming
-	proto         *protobuf.Field
+	isHTTPService    bool
+	bufValidate      bool
+	required         bool
+	validation       *validation.Call
+	domainValidation *validation.Call
+	naming           *FieldNaming
+	proto            *protobuf.Field
 }
 
 // NewFieldValidation creates a new FieldValidation instance.
@@ -41,39 +45,108 @@ func NewFieldValidation(options FieldValidationOptions) (*FieldValidation, error
 
 	fieldExtensions := loadFieldExtensions(options.ProtoField)
 
-	call, err := newValidationCall(options, fieldExtensions)
+	bufRules := loadBufValidateRules(options)
+	call, err := newValidationCall(options, fieldExtensions, bufRules)
 	if err != nil {
 		return nil, err
 	}
 
+	domainCall, err := newDomainValidationCall(options, fieldExtensions, bufRules)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rules       = fieldExtensions.GetValidate()
+		bufValidate = bufRules != nil && rules == nil
+	)
+
 	return &FieldValidation{
-		isHTTPService: options.IsHTTPService,
-		validation:    call,
-		naming:        options.FieldNaming,
-		proto:         options.ProtoField,
+		isHTTPService:    options.IsHTTPService,
+		bufValidate:      bufValidate,
+		required:         (rules.GetRequired() && !rules.GetSkip()) || (bufValidate && bufRules.GetRequired()),
+		validation:       call,
+		domainValidation: domainCall,
+		naming:           options.FieldNaming,
+		proto:            options.ProtoField,
 	}, nil
 }
 
 func newValidationCall(
 	options FieldValidationOptions,
 	ext *extensions.MikrosFieldExtensions,
+	bufRules *validate.FieldRules,
 ) (*validation.Call, error) {
 	if options.Settings == nil {
 		return nil, nil
 	}
 
 	return validation.NewCall(&validation.CallOptions{
-		IsArray:   options.ProtoField.IsArray(),
-		IsMessage: options.ProtoField.IsMessage(),
-		ProtoName: options.ProtoField.Name,
-		Receiver:  options.Receiver,
-		WireType:  options.FieldType.Wire(false),
-		Options:   ext,
-		Settings:  options.Settings,
-		Message:   options.ProtoMessage,
+		IsArray:    options.ProtoField.IsArray(),
+		IsMessage:  options.ProtoField.IsMessage(),
+		ProtoName:  options.ProtoField.Name,
+		Receiver:   options.Receiver,
+		WireType:   options.FieldType.Wire(false),
+		Options:    ext,
+		BufRules:   bufRules,
+		Settings:   options.Settings,
+		Message:    options.ProtoMessage,
+		ProtoField: options.ProtoField,
+
+		StorageType: options.FieldType.storageType,
 	})
 }
 
+// newDomainValidationCall creates the validation call of the field inside
+// its domain structure, when validations are enabled for them.
+func newDomainValidationCall(
+	options FieldValidationOptions,
+	ext *extensions.MikrosFieldExtensions,
+	bufRules *validate.FieldRules,
+) (*validation.Call, error) {
+	if options.Settings == nil || !options.Settings.DomainValidationEnabled() {
+		return nil, nil
+	}
+	if options.FieldType.msg.Kind(options.ProtoMessage.Name) != Wire {
+		return nil, nil
+	}
+
+	// Rules are declared for the wire type, which is not the domain one
+	// when the field has a storage type. Its format is checked by the wire
+	// validation instead.
+	if options.FieldType.storageType != "" {
+		return nil, nil
+	}
+
+	return validation.NewCall(&validation.CallOptions{
+		IsArray:    options.ProtoField.IsArray(),
+		IsMessage:  options.ProtoField.IsMessage(),
+		ProtoName:  options.ProtoField.Name,
+		Receiver:   options.Receiver,
+		WireType:   options.FieldType.Wire(false),
+		Options:    ext,
+		BufRules:   bufRules,
+		Settings:   options.Settings,
+		Message:    options.ProtoMessage,
+		ProtoField: options.ProtoField,
+		Domain:     true,
+		DomainType: options.FieldType.Domain(false),
+		FieldName: func(field *protobuf.Field) string {
+			return buildDomainName(field.GoName, loadFieldExtensions(field))
+		},
+	})
+}
+
+// loadBufValidateRules loads the buf.validate rules of the field when their
+// support is enabled.
+func loadBufValidateRules(options FieldValidationOptions) *validate.FieldRules {
+	if options.Settings == nil || !options.Settings.BufValidateEnabled() {
+		return nil
+	}
+
+	return extensions.LoadBufValidateFieldRules(options.ProtoField.Proto)
+}
+
 // CallFunctionName constructs and returns the validation call name for the
 // field.
 func (f *FieldValidation) CallFunctionName(receiver string) string {
@@ -98,6 +171,40 @@ func (f *FieldValidation) needsAddressNotation() bool {
 		!f.proto.IsOptional()
 }
 
+// HasBufValidateRules returns true if the field validation was translated
+// from buf.validate rules.
+func (f *FieldValidation) HasBufValidateRules() bool {
+	return f.bufValidate && f.Call() != ""
+}
+
+// HasStorageTypeRule returns true if the field validation checks the format
+// of its database storage type.
+func (f *FieldValidation) HasStorageTypeRule() bool {
+	return f.validation != nil && f.validation.HasStorageTypeRule()
+}
+
+// IsRequired returns true if the field is always required by its
+// validation, i.e., without conditions.
+func (f *FieldValidation) IsRequired() bool {
+	return f.required
+}
+
+// DomainCallFunctionName returns the validation call name for the field
+// inside its domain structure.
+func (f *FieldValidation) DomainCallFunctionName(receiver string) string {
+	return fmt.Sprintf("&%s.%s", receiver, f.naming.Domain())
+}
+
+// DomainCall retrieves the validation API call of the field inside its
+// domain structure if it exists.
+func (f *FieldValidation) DomainCall() string {
+	if f.domainValidation == nil {
+		return ""
+	}
+
+	return f.domainValidation.APICall()
+}
+
 // Call retrieves the validation API call from the field's validation
 // if it exists.
 func (f *FieldValidation) Call() string {
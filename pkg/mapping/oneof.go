@@ -0,0 +1,127 @@
+package mapping
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/stoewer/go-strcase"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/internal/validation"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+)
+
+// OneofOptions represents the options used to create a new Oneof.
+type OneofOptions struct {
+	ProtoOneof   *protobuf.Oneof   `validate:"required"`
+	ProtoMessage *protobuf.Message `validate:"required"`
+	Settings     *settings.Settings
+	Validate     *validator.Validate
+}
+
+// Oneof is the mechanism that allows mapping a oneof into its discriminated
+// domain representation, i.e., a struct holding which member is set and a
+// pointer for each one of its members.
+type Oneof struct {
+	domainName   string
+	domainTag    string
+	databaseName string
+	kindTag      string
+	inboundTag   string
+	requiredCall string
+	extensions   *extensions.MikrosOneofExtensions
+}
+
+// NewOneof creates a new Oneof instance.
+func NewOneof(options *OneofOptions) (*Oneof, error) {
+	validate := options.Validate
+	if validate == nil {
+		validate = validator.New()
+	}
+	if err := validate.Struct(options); err != nil {
+		return nil, err
+	}
+
+	var (
+		databaseKind      = DatabaseKind(options.ProtoMessage, options.Settings)
+		messageExtensions = loadMessageExtensions(options.ProtoMessage)
+		db                = NewTagGenerator(databaseKind, nil)
+		domainNameMode    = extensions.NamingMode_NAMING_MODE_SNAKE_CASE
+	)
+
+	if messageDomain := messageExtensions.GetDomain(); messageDomain != nil {
+		domainNameMode = messageDomain.GetNamingMode()
+	}
+
+	var (
+		domainName   = resolveNameForTag(options.ProtoOneof.GoName, domainNameMode)
+		dbTag        = db.GenerateTag(domainName)
+		databaseName = domainName
+	)
+
+	// gorm has no way to map a struct into a single column by itself, so
+	// the whole oneof is stored as JSON inside the column that its naming
+	// strategy gives to the struct member.
+	if databaseKind == "gorm" {
+		dbTag = `gorm:"serializer:json"`
+		databaseName = strcase.SnakeCase(options.ProtoOneof.GoName)
+	}
+
+	oneofExtensions := extensions.LoadOneofExtensions(options.ProtoOneof.Proto)
+	errorCall, err := validation.BuildErrorCall(&validation.ErrorCallOptions{
+		ProtoName:  options.ProtoOneof.Name,
+		Message:    oneofExtensions.GetValidate().GetErrorMessage(),
+		MessageKey: oneofExtensions.GetValidate().GetErrorMessageKey(),
+		Settings:   options.Settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Oneof{
+		domainName:   options.ProtoOneof.GoName,
+		domainTag:    buildTag(domainName, "omitempty", dbTag, nil),
+		databaseName: databaseName,
+		kindTag:      buildTag("kind", "", db.GenerateTag("kind"), nil),
+		inboundTag:   buildInboundTag(buildInboundName(options.ProtoOneof.GoName, nil, messageExtensions)),
+		requiredCall: "validation.Required" + errorCall,
+		extensions:   oneofExtensions,
+	}, nil
+}
+
+// Domain returns the oneof name inside the domain structure.
+func (o *Oneof) Domain() string {
+	return o.domainName
+}
+
+// DomainTag returns the struct tag of the oneof inside the domain structure.
+func (o *Oneof) DomainTag() string {
+	return o.domainTag
+}
+
+// DatabaseName returns the name of the column, or attribute, where the
+// oneof is stored.
+func (o *Oneof) DatabaseName() string {
+	return o.databaseName
+}
+
+// KindTag returns the struct tag of the member that identifies which oneof
+// member is set.
+func (o *Oneof) KindTag() string {
+	return o.kindTag
+}
+
+// InboundTag returns the struct tag of the oneof inside the inbound structure.
+func (o *Oneof) InboundTag() string {
+	return o.inboundTag
+}
+
+// IsRequired returns true if one of the oneof members must be set.
+func (o *Oneof) IsRequired() bool {
+	return o.extensions.GetValidate().GetOneofRequired()
+}
+
+// RequiredCall returns the validation call that checks if one of the oneof
+// members is set.
+func (o *Oneof) RequiredCall() string {
+	return o.requiredCall
+}
@@ -0,0 +1,60 @@
+package mapping
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Well-known types that have their own conversions.
+const (
+	timestampTypeName  protoreflect.FullName = "google.protobuf.Timestamp"
+	durationTypeName   protoreflect.FullName = "google.protobuf.Duration"
+	fieldMaskTypeName  protoreflect.FullName = "google.protobuf.FieldMask"
+	structTypeName     protoreflect.FullName = "google.protobuf.Struct"
+	valueTypeName      protoreflect.FullName = "google.protobuf.Value"
+	anyTypeName        protoreflect.FullName = "google.protobuf.Any"
+	bytesValueTypeName protoreflect.FullName = "google.protobuf.BytesValue"
+)
+
+// wellKnownType describes how a google.protobuf well-known type is represented
+// by the generated sources.
+type wellKnownType struct {
+	// wire is the protobuf Go API type, with its package alias.
+	wire string
+
+	// domain is the type used inside domain and outbound structures.
+	domain string
+
+	// pointer tells if the domain type can be used as a pointer.
+	pointer bool
+
+	// wrapper is the wrapperspb constructor name of wrapper types.
+	wrapper string
+}
+
+var wellKnownTypes = map[protoreflect.FullName]*wellKnownType{
+	timestampTypeName:             {wire: "ts.Timestamp", domain: "time.Time", pointer: true},
+	durationTypeName:              {wire: "durationpb.Duration", domain: "time.Duration", pointer: true},
+	fieldMaskTypeName:             {wire: "fieldmaskpb.FieldMask", domain: "[]string"},
+	structTypeName:                {wire: "structpb.Struct", domain: "map[string]interface{}"},
+	valueTypeName:                 {wire: "structpb.Value", domain: "interface{}"},
+	anyTypeName:                   {wire: "anypb.Any", domain: "proto.Message"},
+	bytesValueTypeName:            {wire: "wrapperspb.BytesValue", domain: "[]byte", wrapper: "Bytes"},
+	"google.protobuf.DoubleValue": {wire: "wrapperspb.DoubleValue", domain: "float64", pointer: true, wrapper: "Double"},
+	"google.protobuf.FloatValue":  {wire: "wrapperspb.FloatValue", domain: "float32", pointer: true, wrapper: "Float"},
+	"google.protobuf.Int64Value":  {wire: "wrapperspb.Int64Value", domain: "int64", pointer: true, wrapper: "Int64"},
+	"google.protobuf.UInt64Value": {wire: "wrapperspb.UInt64Value", domain: "uint64", pointer: true, wrapper: "UInt64"},
+	"google.protobuf.Int32Value":  {wire: "wrapperspb.Int32Value", domain: "int32", pointer: true, wrapper: "Int32"},
+	"google.protobuf.UInt32Value": {wire: "wrapperspb.UInt32Value", domain: "uint32", pointer: true, wrapper: "UInt32"},
+	"google.protobuf.BoolValue":   {wire: "wrapperspb.BoolValue", domain: "bool", pointer: true, wrapper: "Bool"},
+	"google.protobuf.StringValue": {wire: "wrapperspb.StringValue", domain: "string", pointer: true, wrapper: "String"},
+}
+
+// descriptorWellKnownType returns the well-known type of a message descriptor.
+func descriptorWellKnownType(desc protoreflect.MessageDescriptor) (*wellKnownType, bool) {
+	if desc == nil {
+		return nil, false
+	}
+
+	t, ok := wellKnownTypes[desc.FullName()]
+	return t, ok
+}
@@ -15,6 +15,18 @@ type Enum struct {
 	Prefix string
 	Values []*EnumEntry
 	Proto  *descriptor.EnumDescriptorProto
+	closed bool
+}
+
+// DefaultValue returns the default value of the enum. Open enums use zero,
+// while closed ones, whose 'enum_type' feature is CLOSED, can't hold values
+// that are not declared and use their first declared value.
+func (e *Enum) DefaultValue() int32 {
+	if !e.closed || len(e.Values) == 0 {
+		return 0
+	}
+
+	return e.Values[0].Proto.GetNumber()
 }
 
 func (e *Enum) String() string {
@@ -58,44 +70,53 @@ func ParseEnumsFromFile(file *protogen.File) []*Enum {
 	var enums []*Enum
 
 	// Parse all enums declared inside messages
-	for _, msg := range file.Proto.MessageType {
-		for _, enum := range msg.EnumType {
-			enums = append(enums, parseEnumFromMessage(enum, msg))
+	for i, msg := range file.Proto.MessageType {
+		for j, enum := range msg.EnumType {
+			enums = append(enums, parseEnumFromMessage(enum, file.Messages[i].Enums[j], msg))
 		}
 	}
 
 	// Parse all global enums
-	for _, enum := range file.Proto.EnumType {
-		enums = append(enums, parseEnum(enum))
+	for i, enum := range file.Proto.EnumType {
+		enums = append(enums, parseEnum(enum, file.Enums[i]))
 	}
 
 	return enums
 }
 
-func parseEnumFromMessage(protoEnum *descriptor.EnumDescriptorProto, msg *descriptor.DescriptorProto) *Enum {
+func parseEnumFromMessage(
+	protoEnum *descriptor.EnumDescriptorProto,
+	schema *protogen.Enum,
+	msg *descriptor.DescriptorProto,
+) *Enum {
 	name := fmt.Sprintf("%s_%s", msg.GetName(), protoEnum.GetName())
-	prefix := strings.ToUpper(strings.Join(camelcase.Split(protoEnum.GetName()), "_")) + "_"
-
 	return &Enum{
 		Name:   name,
-		Prefix: prefix,
+		Prefix: EnumPrefix(protoEnum.GetName()),
 		Values: parseEnumValues(protoEnum),
 		Proto:  protoEnum,
+		closed: schema.Desc.IsClosed(),
 	}
 }
 
-func parseEnum(protoEnum *descriptor.EnumDescriptorProto) *Enum {
+func parseEnum(protoEnum *descriptor.EnumDescriptorProto, schema *protogen.Enum) *Enum {
 	name := protoEnum.GetName()
-	prefix := strings.ToUpper(strings.Join(camelcase.Split(name), "_")) + "_"
 
 	return &Enum{
 		Name:   name,
-		Prefix: prefix,
+		Prefix: EnumPrefix(name),
 		Values: parseEnumValues(protoEnum),
 		Proto:  protoEnum,
+		closed: schema.Desc.IsClosed(),
 	}
 }
 
+// EnumPrefix returns the prefix of the enum value names, which is the enum
+// name in upper snake case.
+func EnumPrefix(name string) string {
+	return strings.ToUpper(strings.Join(camelcase.Split(name), "_")) + "_"
+}
+
 func parseEnumValues(protoEnum *descriptor.EnumDescriptorProto) []*EnumEntry {
 	var entries []*EnumEntry
 
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.36.6
 // 	protoc        v5.29.3
 // source: proto/mikros_extensions.proto
 
@@ -12,6 +12,7 @@ import (
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -77,6 +78,256 @@ func (AuthorizationMode) EnumDescriptor() ([]byte, []int) {
 	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{0}
 }
 
+type DatabaseStorageType int32
+
+const (
+	DatabaseStorageType_DATABASE_STORAGE_TYPE_UNSPECIFIED DatabaseStorageType = 0
+	DatabaseStorageType_DATABASE_STORAGE_TYPE_OBJECT_ID   DatabaseStorageType = 1
+	DatabaseStorageType_DATABASE_STORAGE_TYPE_DECIMAL128  DatabaseStorageType = 2
+	DatabaseStorageType_DATABASE_STORAGE_TYPE_DATE        DatabaseStorageType = 3
+)
+
+// Enum value maps for DatabaseStorageType.
+var (
+	DatabaseStorageType_name = map[int32]string{
+		0: "DATABASE_STORAGE_TYPE_UNSPECIFIED",
+		1: "DATABASE_STORAGE_TYPE_OBJECT_ID",
+		2: "DATABASE_STORAGE_TYPE_DECIMAL128",
+		3: "DATABASE_STORAGE_TYPE_DATE",
+	}
+	DatabaseStorageType_value = map[string]int32{
+		"DATABASE_STORAGE_TYPE_UNSPECIFIED": 0,
+		"DATABASE_STORAGE_TYPE_OBJECT_ID":   1,
+		"DATABASE_STORAGE_TYPE_DECIMAL128":  2,
+		"DATABASE_STORAGE_TYPE_DATE":        3,
+	}
+)
+
+func (x DatabaseStorageType) Enum() *DatabaseStorageType {
+	p := new(DatabaseStorageType)
+	*p = x
+	return p
+}
+
+func (x DatabaseStorageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseStorageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mikros_extensions_proto_enumTypes[1].Descriptor()
+}
+
+func (DatabaseStorageType) Type() protoreflect.EnumType {
+	return &file_proto_mikros_extensions_proto_enumTypes[1]
+}
+
+func (x DatabaseStorageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *DatabaseStorageType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = DatabaseStorageType(num)
+	return nil
+}
+
+// Deprecated: Use DatabaseStorageType.Descriptor instead.
+func (DatabaseStorageType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{1}
+}
+
+type DatabaseRelationKind int32
+
+const (
+	DatabaseRelationKind_DATABASE_RELATION_KIND_UNSPECIFIED DatabaseRelationKind = 0
+	DatabaseRelationKind_DATABASE_RELATION_KIND_HAS_ONE     DatabaseRelationKind = 1
+	DatabaseRelationKind_DATABASE_RELATION_KIND_HAS_MANY    DatabaseRelationKind = 2
+	DatabaseRelationKind_DATABASE_RELATION_KIND_BELONGS_TO  DatabaseRelationKind = 3
+)
+
+// Enum value maps for DatabaseRelationKind.
+var (
+	DatabaseRelationKind_name = map[int32]string{
+		0: "DATABASE_RELATION_KIND_UNSPECIFIED",
+		1: "DATABASE_RELATION_KIND_HAS_ONE",
+		2: "DATABASE_RELATION_KIND_HAS_MANY",
+		3: "DATABASE_RELATION_KIND_BELONGS_TO",
+	}
+	DatabaseRelationKind_value = map[string]int32{
+		"DATABASE_RELATION_KIND_UNSPECIFIED": 0,
+		"DATABASE_RELATION_KIND_HAS_ONE":     1,
+		"DATABASE_RELATION_KIND_HAS_MANY":    2,
+		"DATABASE_RELATION_KIND_BELONGS_TO":  3,
+	}
+)
+
+func (x DatabaseRelationKind) Enum() *DatabaseRelationKind {
+	p := new(DatabaseRelationKind)
+	*p = x
+	return p
+}
+
+func (x DatabaseRelationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseRelationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mikros_extensions_proto_enumTypes[2].Descriptor()
+}
+
+func (DatabaseRelationKind) Type() protoreflect.EnumType {
+	return &file_proto_mikros_extensions_proto_enumTypes[2]
+}
+
+func (x DatabaseRelationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *DatabaseRelationKind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = DatabaseRelationKind(num)
+	return nil
+}
+
+// Deprecated: Use DatabaseRelationKind.Descriptor instead.
+func (DatabaseRelationKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{2}
+}
+
+type DatabaseSerializer int32
+
+const (
+	DatabaseSerializer_DATABASE_SERIALIZER_UNSPECIFIED DatabaseSerializer = 0
+	DatabaseSerializer_DATABASE_SERIALIZER_JSON        DatabaseSerializer = 1
+	DatabaseSerializer_DATABASE_SERIALIZER_JSONB       DatabaseSerializer = 2
+)
+
+// Enum value maps for DatabaseSerializer.
+var (
+	DatabaseSerializer_name = map[int32]string{
+		0: "DATABASE_SERIALIZER_UNSPECIFIED",
+		1: "DATABASE_SERIALIZER_JSON",
+		2: "DATABASE_SERIALIZER_JSONB",
+	}
+	DatabaseSerializer_value = map[string]int32{
+		"DATABASE_SERIALIZER_UNSPECIFIED": 0,
+		"DATABASE_SERIALIZER_JSON":        1,
+		"DATABASE_SERIALIZER_JSONB":       2,
+	}
+)
+
+func (x DatabaseSerializer) Enum() *DatabaseSerializer {
+	p := new(DatabaseSerializer)
+	*p = x
+	return p
+}
+
+func (x DatabaseSerializer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseSerializer) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mikros_extensions_proto_enumTypes[3].Descriptor()
+}
+
+func (DatabaseSerializer) Type() protoreflect.EnumType {
+	return &file_proto_mikros_extensions_proto_enumTypes[3]
+}
+
+func (x DatabaseSerializer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *DatabaseSerializer) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = DatabaseSerializer(num)
+	return nil
+}
+
+// Deprecated: Use DatabaseSerializer.Descriptor instead.
+func (DatabaseSerializer) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{3}
+}
+
+type BytesEncoding int32
+
+const (
+	BytesEncoding_BYTES_ENCODING_UNSPECIFIED BytesEncoding = 0
+	BytesEncoding_BYTES_ENCODING_BASE64      BytesEncoding = 1
+	BytesEncoding_BYTES_ENCODING_BASE64_URL  BytesEncoding = 2
+	BytesEncoding_BYTES_ENCODING_HEX         BytesEncoding = 3
+	// Raw bytes are used as they are. They can't be exported to outbound
+	// structures, since JSON strings can't hold them.
+	BytesEncoding_BYTES_ENCODING_RAW BytesEncoding = 4
+)
+
+// Enum value maps for BytesEncoding.
+var (
+	BytesEncoding_name = map[int32]string{
+		0: "BYTES_ENCODING_UNSPECIFIED",
+		1: "BYTES_ENCODING_BASE64",
+		2: "BYTES_ENCODING_BASE64_URL",
+		3: "BYTES_ENCODING_HEX",
+		4: "BYTES_ENCODING_RAW",
+	}
+	BytesEncoding_value = map[string]int32{
+		"BYTES_ENCODING_UNSPECIFIED": 0,
+		"BYTES_ENCODING_BASE64":      1,
+		"BYTES_ENCODING_BASE64_URL":  2,
+		"BYTES_ENCODING_HEX":         3,
+		"BYTES_ENCODING_RAW":         4,
+	}
+)
+
+func (x BytesEncoding) Enum() *BytesEncoding {
+	p := new(BytesEncoding)
+	*p = x
+	return p
+}
+
+func (x BytesEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BytesEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mikros_extensions_proto_enumTypes[4].Descriptor()
+}
+
+func (BytesEncoding) Type() protoreflect.EnumType {
+	return &file_proto_mikros_extensions_proto_enumTypes[4]
+}
+
+func (x BytesEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *BytesEncoding) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = BytesEncoding(num)
+	return nil
+}
+
+// Deprecated: Use BytesEncoding.Descriptor instead.
+func (BytesEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{4}
+}
+
 type FieldValidatorRule int32
 
 const (
@@ -110,11 +361,11 @@ func (x FieldValidatorRule) String() string {
 }
 
 func (FieldValidatorRule) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_mikros_extensions_proto_enumTypes[1].Descriptor()
+	return file_proto_mikros_extensions_proto_enumTypes[5].Descriptor()
 }
 
 func (FieldValidatorRule) Type() protoreflect.EnumType {
-	return &file_proto_mikros_extensions_proto_enumTypes[1]
+	return &file_proto_mikros_extensions_proto_enumTypes[5]
 }
 
 func (x FieldValidatorRule) Number() protoreflect.EnumNumber {
@@ -133,7 +384,7 @@ func (x *FieldValidatorRule) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use FieldValidatorRule.Descriptor instead.
 func (FieldValidatorRule) EnumDescriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{1}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{5}
 }
 
 type NamingMode int32
@@ -166,11 +417,11 @@ func (x NamingMode) String() string {
 }
 
 func (NamingMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_mikros_extensions_proto_enumTypes[2].Descriptor()
+	return file_proto_mikros_extensions_proto_enumTypes[6].Descriptor()
 }
 
 func (NamingMode) Type() protoreflect.EnumType {
-	return &file_proto_mikros_extensions_proto_enumTypes[2]
+	return &file_proto_mikros_extensions_proto_enumTypes[6]
 }
 
 func (x NamingMode) Number() protoreflect.EnumNumber {
@@ -189,24 +440,21 @@ func (x *NamingMode) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use NamingMode.Descriptor instead.
 func (NamingMode) EnumDescriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{2}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{6}
 }
 
 type MikrosServiceExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state         protoimpl.MessageState       `protogen:"open.v1"`
 	Authorization *HttpAuthorizationExtensions `protobuf:"bytes,1,opt,name=authorization" json:"authorization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MikrosServiceExtensions) Reset() {
 	*x = MikrosServiceExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosServiceExtensions) String() string {
@@ -217,7 +465,7 @@ func (*MikrosServiceExtensions) ProtoMessage() {}
 
 func (x *MikrosServiceExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -240,21 +488,18 @@ func (x *MikrosServiceExtensions) GetAuthorization() *HttpAuthorizationExtension
 }
 
 type HttpAuthorizationExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Mode           *AuthorizationMode `protobuf:"varint,1,req,name=mode,enum=mikros.extensions.AuthorizationMode" json:"mode,omitempty"`
-	CustomAuthName *string            `protobuf:"bytes,2,opt,name=custom_auth_name,json=customAuthName" json:"custom_auth_name,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Mode           *AuthorizationMode     `protobuf:"varint,1,req,name=mode,enum=mikros.extensions.AuthorizationMode" json:"mode,omitempty"`
+	CustomAuthName *string                `protobuf:"bytes,2,opt,name=custom_auth_name,json=customAuthName" json:"custom_auth_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *HttpAuthorizationExtensions) Reset() {
 	*x = HttpAuthorizationExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HttpAuthorizationExtensions) String() string {
@@ -265,7 +510,7 @@ func (*HttpAuthorizationExtensions) ProtoMessage() {}
 
 func (x *HttpAuthorizationExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -295,20 +540,17 @@ func (x *HttpAuthorizationExtensions) GetCustomAuthName() string {
 }
 
 type MikrosMethodExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Http          *HttpMethodExtensions  `protobuf:"bytes,1,opt,name=http" json:"http,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Http *HttpMethodExtensions `protobuf:"bytes,1,opt,name=http" json:"http,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MikrosMethodExtensions) Reset() {
 	*x = MikrosMethodExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosMethodExtensions) String() string {
@@ -319,7 +561,7 @@ func (*MikrosMethodExtensions) ProtoMessage() {}
 
 func (x *MikrosMethodExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -342,22 +584,19 @@ func (x *MikrosMethodExtensions) GetHttp() *HttpMethodExtensions {
 }
 
 type HttpMethodExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Header                []string `protobuf:"bytes,1,rep,name=header" json:"header,omitempty"`
-	AuthArg               []string `protobuf:"bytes,2,rep,name=auth_arg,json=authArg" json:"auth_arg,omitempty"`
-	ParseRequestInService *bool    `protobuf:"varint,3,opt,name=parse_request_in_service,json=parseRequestInService" json:"parse_request_in_service,omitempty"`
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Header                []string               `protobuf:"bytes,1,rep,name=header" json:"header,omitempty"`
+	AuthArg               []string               `protobuf:"bytes,2,rep,name=auth_arg,json=authArg" json:"auth_arg,omitempty"`
+	ParseRequestInService *bool                  `protobuf:"varint,3,opt,name=parse_request_in_service,json=parseRequestInService" json:"parse_request_in_service,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *HttpMethodExtensions) Reset() {
 	*x = HttpMethodExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HttpMethodExtensions) String() string {
@@ -368,7 +607,7 @@ func (*HttpMethodExtensions) ProtoMessage() {}
 
 func (x *HttpMethodExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -405,20 +644,17 @@ func (x *HttpMethodExtensions) GetParseRequestInService() bool {
 }
 
 type MikrosEnumExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Api           *EnumApiExtensions     `protobuf:"bytes,1,opt,name=api" json:"api,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Api *EnumApiExtensions `protobuf:"bytes,1,opt,name=api" json:"api,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MikrosEnumExtensions) Reset() {
 	*x = MikrosEnumExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosEnumExtensions) String() string {
@@ -429,7 +665,7 @@ func (*MikrosEnumExtensions) ProtoMessage() {}
 
 func (x *MikrosEnumExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -452,21 +688,18 @@ func (x *MikrosEnumExtensions) GetApi() *EnumApiExtensions {
 }
 
 type EnumApiExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bitflag       *bool                  `protobuf:"varint,1,opt,name=bitflag" json:"bitflag,omitempty"`
+	ErrorCode     *bool                  `protobuf:"varint,2,opt,name=error_code,json=errorCode" json:"error_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Bitflag   *bool `protobuf:"varint,1,opt,name=bitflag" json:"bitflag,omitempty"`
-	ErrorCode *bool `protobuf:"varint,2,opt,name=error_code,json=errorCode" json:"error_code,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnumApiExtensions) Reset() {
 	*x = EnumApiExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EnumApiExtensions) String() string {
@@ -477,7 +710,7 @@ func (*EnumApiExtensions) ProtoMessage() {}
 
 func (x *EnumApiExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -507,20 +740,17 @@ func (x *EnumApiExtensions) GetErrorCode() bool {
 }
 
 type MikrosEnumValueExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *EnumEntry             `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Entry *EnumEntry `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MikrosEnumValueExtensions) Reset() {
 	*x = MikrosEnumValueExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosEnumValueExtensions) String() string {
@@ -531,7 +761,7 @@ func (*MikrosEnumValueExtensions) ProtoMessage() {}
 
 func (x *MikrosEnumValueExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -554,20 +784,17 @@ func (x *MikrosEnumValueExtensions) GetEntry() *EnumEntry {
 }
 
 type EnumEntry struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnumEntry) Reset() {
 	*x = EnumEntry{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EnumEntry) String() string {
@@ -578,7 +805,7 @@ func (*EnumEntry) ProtoMessage() {}
 
 func (x *EnumEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -601,25 +828,22 @@ func (x *EnumEntry) GetName() string {
 }
 
 type MikrosFieldExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *FieldDomainOptions    `protobuf:"bytes,1,opt,name=domain" json:"domain,omitempty"`
+	Database      *FieldDatabaseOptions  `protobuf:"bytes,2,opt,name=database" json:"database,omitempty"`
+	Inbound       *FieldInboundOptions   `protobuf:"bytes,3,opt,name=inbound" json:"inbound,omitempty"`
+	Outbound      *FieldOutboundOptions  `protobuf:"bytes,4,opt,name=outbound" json:"outbound,omitempty"`
+	Validate      *FieldValidateOptions  `protobuf:"bytes,5,opt,name=validate" json:"validate,omitempty"`
+	Testing       *FieldTestingOptions   `protobuf:"bytes,6,opt,name=testing" json:"testing,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Domain   *FieldDomainOptions   `protobuf:"bytes,1,opt,name=domain" json:"domain,omitempty"`
-	Database *FieldDatabaseOptions `protobuf:"bytes,2,opt,name=database" json:"database,omitempty"`
-	Inbound  *FieldInboundOptions  `protobuf:"bytes,3,opt,name=inbound" json:"inbound,omitempty"`
-	Outbound *FieldOutboundOptions `protobuf:"bytes,4,opt,name=outbound" json:"outbound,omitempty"`
-	Validate *FieldValidateOptions `protobuf:"bytes,5,opt,name=validate" json:"validate,omitempty"`
-	Testing  *FieldTestingOptions  `protobuf:"bytes,6,opt,name=testing" json:"testing,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MikrosFieldExtensions) Reset() {
 	*x = MikrosFieldExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosFieldExtensions) String() string {
@@ -630,7 +854,7 @@ func (*MikrosFieldExtensions) ProtoMessage() {}
 
 func (x *MikrosFieldExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -688,22 +912,20 @@ func (x *MikrosFieldExtensions) GetTesting() *FieldTestingOptions {
 }
 
 type FieldDomainOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	AllowEmpty    *bool                  `protobuf:"varint,2,opt,name=allow_empty,json=allowEmpty" json:"allow_empty,omitempty"`
+	StructTag     []*FieldStructTag      `protobuf:"bytes,3,rep,name=struct_tag,json=structTag" json:"struct_tag,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue" json:"default_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name       *string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	AllowEmpty *bool             `protobuf:"varint,2,opt,name=allow_empty,json=allowEmpty" json:"allow_empty,omitempty"`
-	StructTag  []*FieldStructTag `protobuf:"bytes,3,rep,name=struct_tag,json=structTag" json:"struct_tag,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FieldDomainOptions) Reset() {
 	*x = FieldDomainOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FieldDomainOptions) String() string {
@@ -714,7 +936,7 @@ func (*FieldDomainOptions) ProtoMessage() {}
 
 func (x *FieldDomainOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -750,22 +972,26 @@ func (x *FieldDomainOptions) GetStructTag() []*FieldStructTag {
 	return nil
 }
 
+func (x *FieldDomainOptions) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
 type FieldStructTag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Value         *string                `protobuf:"bytes,2,req,name=value" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name  *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Value *string `protobuf:"bytes,2,req,name=value" json:"value,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FieldStructTag) Reset() {
 	*x = FieldStructTag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FieldStructTag) String() string {
@@ -776,7 +1002,7 @@ func (*FieldStructTag) ProtoMessage() {}
 
 func (x *FieldStructTag) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -806,26 +1032,35 @@ func (x *FieldStructTag) GetValue() string {
 }
 
 type FieldDatabaseOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name          *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	AllowEmpty    *bool   `protobuf:"varint,2,opt,name=allow_empty,json=allowEmpty" json:"allow_empty,omitempty"`
-	Index         *bool   `protobuf:"varint,3,opt,name=index" json:"index,omitempty"`
-	Unique        *bool   `protobuf:"varint,4,opt,name=unique" json:"unique,omitempty"`
-	UniqueIndex   *bool   `protobuf:"varint,5,opt,name=unique_index,json=uniqueIndex" json:"unique_index,omitempty"`
-	PrimaryKey    *bool   `protobuf:"varint,6,opt,name=primary_key,json=primaryKey" json:"primary_key,omitempty"`
-	AutoIncrement *bool   `protobuf:"varint,7,opt,name=auto_increment,json=autoIncrement" json:"auto_increment,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	AllowEmpty     *bool                  `protobuf:"varint,2,opt,name=allow_empty,json=allowEmpty" json:"allow_empty,omitempty"`
+	Index          *bool                  `protobuf:"varint,3,opt,name=index" json:"index,omitempty"`
+	Unique         *bool                  `protobuf:"varint,4,opt,name=unique" json:"unique,omitempty"`
+	UniqueIndex    *bool                  `protobuf:"varint,5,opt,name=unique_index,json=uniqueIndex" json:"unique_index,omitempty"`
+	PrimaryKey     *bool                  `protobuf:"varint,6,opt,name=primary_key,json=primaryKey" json:"primary_key,omitempty"`
+	AutoIncrement  *bool                  `protobuf:"varint,7,opt,name=auto_increment,json=autoIncrement" json:"auto_increment,omitempty"`
+	ExpireAfter    *string                `protobuf:"bytes,8,opt,name=expire_after,json=expireAfter" json:"expire_after,omitempty"`
+	PartialFilter  *string                `protobuf:"bytes,9,opt,name=partial_filter,json=partialFilter" json:"partial_filter,omitempty"`
+	PartitionKey   *bool                  `protobuf:"varint,10,opt,name=partition_key,json=partitionKey" json:"partition_key,omitempty"`
+	SortKey        *bool                  `protobuf:"varint,11,opt,name=sort_key,json=sortKey" json:"sort_key,omitempty"`
+	StorageType    *DatabaseStorageType   `protobuf:"varint,12,opt,name=storage_type,json=storageType,enum=mikros.extensions.DatabaseStorageType" json:"storage_type,omitempty"`
+	Embedded       *bool                  `protobuf:"varint,13,opt,name=embedded" json:"embedded,omitempty"`
+	EmbeddedPrefix *string                `protobuf:"bytes,14,opt,name=embedded_prefix,json=embeddedPrefix" json:"embedded_prefix,omitempty"`
+	Relation       *DatabaseRelation      `protobuf:"bytes,15,opt,name=relation" json:"relation,omitempty"`
+	Serializer     *DatabaseSerializer    `protobuf:"varint,16,opt,name=serializer,enum=mikros.extensions.DatabaseSerializer" json:"serializer,omitempty"`
+	ColumnType     *string                `protobuf:"bytes,17,opt,name=column_type,json=columnType" json:"column_type,omitempty"`
+	Size           *int32                 `protobuf:"varint,18,opt,name=size" json:"size,omitempty"`
+	DefaultValue   *string                `protobuf:"bytes,19,opt,name=default_value,json=defaultValue" json:"default_value,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *FieldDatabaseOptions) Reset() {
 	*x = FieldDatabaseOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FieldDatabaseOptions) String() string {
@@ -836,7 +1071,7 @@ func (*FieldDatabaseOptions) ProtoMessage() {}
 
 func (x *FieldDatabaseOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_extensions_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -900,32 +1135,173 @@ func (x *FieldDatabaseOptions) GetAutoIncrement() bool {
 	return false
 }
 
-type FieldInboundOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FieldDatabaseOptions) GetExpireAfter() string {
+	if x != nil && x.ExpireAfter != nil {
+		return *x.ExpireAfter
+	}
+	return ""
+}
 
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+func (x *FieldDatabaseOptions) GetPartialFilter() string {
+	if x != nil && x.PartialFilter != nil {
+		return *x.PartialFilter
+	}
+	return ""
 }
 
-func (x *FieldInboundOptions) Reset() {
-	*x = FieldInboundOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FieldDatabaseOptions) GetPartitionKey() bool {
+	if x != nil && x.PartitionKey != nil {
+		return *x.PartitionKey
 	}
+	return false
 }
 
-func (x *FieldInboundOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FieldDatabaseOptions) GetSortKey() bool {
+	if x != nil && x.SortKey != nil {
+		return *x.SortKey
+	}
+	return false
 }
 
-func (*FieldInboundOptions) ProtoMessage() {}
+func (x *FieldDatabaseOptions) GetStorageType() DatabaseStorageType {
+	if x != nil && x.StorageType != nil {
+		return *x.StorageType
+	}
+	return DatabaseStorageType_DATABASE_STORAGE_TYPE_UNSPECIFIED
+}
 
-func (x *FieldInboundOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *FieldDatabaseOptions) GetEmbedded() bool {
+	if x != nil && x.Embedded != nil {
+		return *x.Embedded
+	}
+	return false
+}
+
+func (x *FieldDatabaseOptions) GetEmbeddedPrefix() string {
+	if x != nil && x.EmbeddedPrefix != nil {
+		return *x.EmbeddedPrefix
+	}
+	return ""
+}
+
+func (x *FieldDatabaseOptions) GetRelation() *DatabaseRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+func (x *FieldDatabaseOptions) GetSerializer() DatabaseSerializer {
+	if x != nil && x.Serializer != nil {
+		return *x.Serializer
+	}
+	return DatabaseSerializer_DATABASE_SERIALIZER_UNSPECIFIED
+}
+
+func (x *FieldDatabaseOptions) GetColumnType() string {
+	if x != nil && x.ColumnType != nil {
+		return *x.ColumnType
+	}
+	return ""
+}
+
+func (x *FieldDatabaseOptions) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *FieldDatabaseOptions) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+type DatabaseRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          *DatabaseRelationKind  `protobuf:"varint,1,opt,name=kind,enum=mikros.extensions.DatabaseRelationKind" json:"kind,omitempty"`
+	ForeignKey    *string                `protobuf:"bytes,2,opt,name=foreign_key,json=foreignKey" json:"foreign_key,omitempty"`
+	References    *string                `protobuf:"bytes,3,opt,name=references" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseRelation) Reset() {
+	*x = DatabaseRelation{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseRelation) ProtoMessage() {}
+
+func (x *DatabaseRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseRelation.ProtoReflect.Descriptor instead.
+func (*DatabaseRelation) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DatabaseRelation) GetKind() DatabaseRelationKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return DatabaseRelationKind_DATABASE_RELATION_KIND_UNSPECIFIED
+}
+
+func (x *DatabaseRelation) GetForeignKey() string {
+	if x != nil && x.ForeignKey != nil {
+		return *x.ForeignKey
+	}
+	return ""
+}
+
+func (x *DatabaseRelation) GetReferences() string {
+	if x != nil && x.References != nil {
+		return *x.References
+	}
+	return ""
+}
+
+type FieldInboundOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldInboundOptions) Reset() {
+	*x = FieldInboundOptions{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldInboundOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldInboundOptions) ProtoMessage() {}
+
+func (x *FieldInboundOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[13]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -937,7 +1313,7 @@ func (x *FieldInboundOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldInboundOptions.ProtoReflect.Descriptor instead.
 func (*FieldInboundOptions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{12}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FieldInboundOptions) GetName() string {
@@ -948,27 +1324,25 @@ func (x *FieldInboundOptions) GetName() string {
 }
 
 type FieldOutboundOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Hide          *bool                  `protobuf:"varint,2,opt,name=hide" json:"hide,omitempty"`
+	Bitflag       *OutboundBitflagField  `protobuf:"bytes,3,opt,name=bitflag" json:"bitflag,omitempty"`
+	AllowEmpty    *bool                  `protobuf:"varint,4,opt,name=allow_empty,json=allowEmpty" json:"allow_empty,omitempty"`
+	StructTag     []*FieldStructTag      `protobuf:"bytes,5,rep,name=struct_tag,json=structTag" json:"struct_tag,omitempty"`
+	CustomBind    *bool                  `protobuf:"varint,6,opt,name=custom_bind,json=customBind" json:"custom_bind,omitempty"`
+	CustomType    *string                `protobuf:"bytes,7,opt,name=custom_type,json=customType" json:"custom_type,omitempty"`
+	CustomImport  *MikrosCustomImport    `protobuf:"bytes,8,opt,name=custom_import,json=customImport" json:"custom_import,omitempty"`
+	BytesEncoding *BytesEncoding         `protobuf:"varint,9,opt,name=bytes_encoding,json=bytesEncoding,enum=mikros.extensions.BytesEncoding" json:"bytes_encoding,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name         *string               `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Hide         *bool                 `protobuf:"varint,2,opt,name=hide" json:"hide,omitempty"`
-	Bitflag      *OutboundBitflagField `protobuf:"bytes,3,opt,name=bitflag" json:"bitflag,omitempty"`
-	AllowEmpty   *bool                 `protobuf:"varint,4,opt,name=allow_empty,json=allowEmpty" json:"allow_empty,omitempty"`
-	StructTag    []*FieldStructTag     `protobuf:"bytes,5,rep,name=struct_tag,json=structTag" json:"struct_tag,omitempty"`
-	CustomBind   *bool                 `protobuf:"varint,6,opt,name=custom_bind,json=customBind" json:"custom_bind,omitempty"`
-	CustomType   *string               `protobuf:"bytes,7,opt,name=custom_type,json=customType" json:"custom_type,omitempty"`
-	CustomImport *MikrosCustomImport   `protobuf:"bytes,8,opt,name=custom_import,json=customImport" json:"custom_import,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FieldOutboundOptions) Reset() {
 	*x = FieldOutboundOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FieldOutboundOptions) String() string {
@@ -978,8 +1352,8 @@ func (x *FieldOutboundOptions) String() string {
 func (*FieldOutboundOptions) ProtoMessage() {}
 
 func (x *FieldOutboundOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[14]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -991,7 +1365,7 @@ func (x *FieldOutboundOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldOutboundOptions.ProtoReflect.Descriptor instead.
 func (*FieldOutboundOptions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{13}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FieldOutboundOptions) GetName() string {
@@ -1050,26 +1424,30 @@ func (x *FieldOutboundOptions) GetCustomImport() *MikrosCustomImport {
 	return nil
 }
 
-type OutboundBitflagField struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FieldOutboundOptions) GetBytesEncoding() BytesEncoding {
+	if x != nil && x.BytesEncoding != nil {
+		return *x.BytesEncoding
+	}
+	return BytesEncoding_BYTES_ENCODING_UNSPECIFIED
+}
 
+type OutboundBitflagField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// Values must point to a valid enum name which holds all the values that the
 	// bitflag represents.
 	Values *string `protobuf:"bytes,1,req,name=values" json:"values,omitempty"`
 	// Sets an optional prefix string that is present in all values that represents
 	// the bitflag.
-	Prefix *string `protobuf:"bytes,2,req,name=prefix" json:"prefix,omitempty"`
+	Prefix        *string `protobuf:"bytes,2,req,name=prefix" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OutboundBitflagField) Reset() {
 	*x = OutboundBitflagField{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *OutboundBitflagField) String() string {
@@ -1079,8 +1457,8 @@ func (x *OutboundBitflagField) String() string {
 func (*OutboundBitflagField) ProtoMessage() {}
 
 func (x *OutboundBitflagField) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[15]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1092,7 +1470,7 @@ func (x *OutboundBitflagField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutboundBitflagField.ProtoReflect.Descriptor instead.
 func (*OutboundBitflagField) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{14}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OutboundBitflagField) GetValues() string {
@@ -1110,35 +1488,42 @@ func (x *OutboundBitflagField) GetPrefix() string {
 }
 
 type FieldValidateOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Rule            *FieldValidatorRule `protobuf:"varint,1,opt,name=rule,enum=mikros.extensions.FieldValidatorRule" json:"rule,omitempty"`
-	RuleArgs        []string            `protobuf:"bytes,2,rep,name=rule_args,json=ruleArgs" json:"rule_args,omitempty"`
-	CustomRule      *string             `protobuf:"bytes,3,opt,name=custom_rule,json=customRule" json:"custom_rule,omitempty"`
-	Required        *bool               `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
-	Min             *int32              `protobuf:"varint,5,opt,name=min" json:"min,omitempty"`
-	Max             *int32              `protobuf:"varint,6,opt,name=max" json:"max,omitempty"`
-	MaxLength       *int32              `protobuf:"varint,7,opt,name=max_length,json=maxLength" json:"max_length,omitempty"`
-	Dive            *bool               `protobuf:"varint,8,opt,name=dive" json:"dive,omitempty"`
-	RequiredIf      *string             `protobuf:"bytes,9,opt,name=required_if,json=requiredIf" json:"required_if,omitempty"`
-	RequiredIfNot   *string             `protobuf:"bytes,10,opt,name=required_if_not,json=requiredIfNot" json:"required_if_not,omitempty"`
-	RequiredWith    *string             `protobuf:"bytes,11,opt,name=required_with,json=requiredWith" json:"required_with,omitempty"`
-	RequiredWithout *string             `protobuf:"bytes,12,opt,name=required_without,json=requiredWithout" json:"required_without,omitempty"`
-	RequiredAll     *string             `protobuf:"bytes,13,opt,name=required_all,json=requiredAll" json:"required_all,omitempty"`
-	RequiredAny     *string             `protobuf:"bytes,14,opt,name=required_any,json=requiredAny" json:"required_any,omitempty"`
-	ErrorMessage    *string             `protobuf:"bytes,15,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
-	Skip            *bool               `protobuf:"varint,16,opt,name=skip" json:"skip,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Rule            *FieldValidatorRule    `protobuf:"varint,1,opt,name=rule,enum=mikros.extensions.FieldValidatorRule" json:"rule,omitempty"`
+	RuleArgs        []string               `protobuf:"bytes,2,rep,name=rule_args,json=ruleArgs" json:"rule_args,omitempty"`
+	CustomRule      *string                `protobuf:"bytes,3,opt,name=custom_rule,json=customRule" json:"custom_rule,omitempty"`
+	Required        *bool                  `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
+	Min             *int32                 `protobuf:"varint,5,opt,name=min" json:"min,omitempty"`
+	Max             *int32                 `protobuf:"varint,6,opt,name=max" json:"max,omitempty"`
+	MaxLength       *int32                 `protobuf:"varint,7,opt,name=max_length,json=maxLength" json:"max_length,omitempty"`
+	Dive            *bool                  `protobuf:"varint,8,opt,name=dive" json:"dive,omitempty"`
+	RequiredIf      *string                `protobuf:"bytes,9,opt,name=required_if,json=requiredIf" json:"required_if,omitempty"`
+	RequiredIfNot   *string                `protobuf:"bytes,10,opt,name=required_if_not,json=requiredIfNot" json:"required_if_not,omitempty"`
+	RequiredWith    *string                `protobuf:"bytes,11,opt,name=required_with,json=requiredWith" json:"required_with,omitempty"`
+	RequiredWithout *string                `protobuf:"bytes,12,opt,name=required_without,json=requiredWithout" json:"required_without,omitempty"`
+	RequiredAll     *string                `protobuf:"bytes,13,opt,name=required_all,json=requiredAll" json:"required_all,omitempty"`
+	RequiredAny     *string                `protobuf:"bytes,14,opt,name=required_any,json=requiredAny" json:"required_any,omitempty"`
+	ErrorMessage    *string                `protobuf:"bytes,15,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+	Skip            *bool                  `protobuf:"varint,16,opt,name=skip" json:"skip,omitempty"`
+	ErrorMessageKey *string                `protobuf:"bytes,17,opt,name=error_message_key,json=errorMessageKey" json:"error_message_key,omitempty"`
+	Keys            *FieldValidateOptions  `protobuf:"bytes,18,opt,name=keys" json:"keys,omitempty"`
+	Values          *FieldValidateOptions  `protobuf:"bytes,19,opt,name=values" json:"values,omitempty"`
+	LtNow           *bool                  `protobuf:"varint,20,opt,name=lt_now,json=ltNow" json:"lt_now,omitempty"`
+	GtNow           *bool                  `protobuf:"varint,21,opt,name=gt_now,json=gtNow" json:"gt_now,omitempty"`
+	Within          *string                `protobuf:"bytes,22,opt,name=within" json:"within,omitempty"`
+	LtField         *string                `protobuf:"bytes,23,opt,name=lt_field,json=ltField" json:"lt_field,omitempty"`
+	GtField         *string                `protobuf:"bytes,24,opt,name=gt_field,json=gtField" json:"gt_field,omitempty"`
+	MinDuration     *string                `protobuf:"bytes,25,opt,name=min_duration,json=minDuration" json:"min_duration,omitempty"`
+	MaxDuration     *string                `protobuf:"bytes,26,opt,name=max_duration,json=maxDuration" json:"max_duration,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *FieldValidateOptions) Reset() {
 	*x = FieldValidateOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FieldValidateOptions) String() string {
@@ -1148,8 +1533,8 @@ func (x *FieldValidateOptions) String() string {
 func (*FieldValidateOptions) ProtoMessage() {}
 
 func (x *FieldValidateOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[16]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1161,7 +1546,7 @@ func (x *FieldValidateOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldValidateOptions.ProtoReflect.Descriptor instead.
 func (*FieldValidateOptions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{15}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *FieldValidateOptions) GetRule() FieldValidatorRule {
@@ -1276,22 +1661,89 @@ func (x *FieldValidateOptions) GetSkip() bool {
 	return false
 }
 
+func (x *FieldValidateOptions) GetErrorMessageKey() string {
+	if x != nil && x.ErrorMessageKey != nil {
+		return *x.ErrorMessageKey
+	}
+	return ""
+}
+
+func (x *FieldValidateOptions) GetKeys() *FieldValidateOptions {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *FieldValidateOptions) GetValues() *FieldValidateOptions {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *FieldValidateOptions) GetLtNow() bool {
+	if x != nil && x.LtNow != nil {
+		return *x.LtNow
+	}
+	return false
+}
+
+func (x *FieldValidateOptions) GetGtNow() bool {
+	if x != nil && x.GtNow != nil {
+		return *x.GtNow
+	}
+	return false
+}
+
+func (x *FieldValidateOptions) GetWithin() string {
+	if x != nil && x.Within != nil {
+		return *x.Within
+	}
+	return ""
+}
+
+func (x *FieldValidateOptions) GetLtField() string {
+	if x != nil && x.LtField != nil {
+		return *x.LtField
+	}
+	return ""
+}
+
+func (x *FieldValidateOptions) GetGtField() string {
+	if x != nil && x.GtField != nil {
+		return *x.GtField
+	}
+	return ""
+}
+
+func (x *FieldValidateOptions) GetMinDuration() string {
+	if x != nil && x.MinDuration != nil {
+		return *x.MinDuration
+	}
+	return ""
+}
+
+func (x *FieldValidateOptions) GetMaxDuration() string {
+	if x != nil && x.MaxDuration != nil {
+		return *x.MaxDuration
+	}
+	return ""
+}
+
 type FieldTestingOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomRule    *string                `protobuf:"bytes,1,req,name=custom_rule,json=customRule" json:"custom_rule,omitempty"`
+	RuleArgs      []string               `protobuf:"bytes,2,rep,name=rule_args,json=ruleArgs" json:"rule_args,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CustomRule *string  `protobuf:"bytes,1,req,name=custom_rule,json=customRule" json:"custom_rule,omitempty"`
-	RuleArgs   []string `protobuf:"bytes,2,rep,name=rule_args,json=ruleArgs" json:"rule_args,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FieldTestingOptions) Reset() {
 	*x = FieldTestingOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FieldTestingOptions) String() string {
@@ -1301,8 +1753,8 @@ func (x *FieldTestingOptions) String() string {
 func (*FieldTestingOptions) ProtoMessage() {}
 
 func (x *FieldTestingOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1314,7 +1766,7 @@ func (x *FieldTestingOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldTestingOptions.ProtoReflect.Descriptor instead.
 func (*FieldTestingOptions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{16}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *FieldTestingOptions) GetCustomRule() string {
@@ -1331,25 +1783,127 @@ func (x *FieldTestingOptions) GetRuleArgs() []string {
 	return nil
 }
 
-type MikrosMessageExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+type MikrosOneofExtensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Validate      *OneofValidateOptions  `protobuf:"bytes,1,opt,name=validate" json:"validate,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Domain    *MessageDomainExtensions    `protobuf:"bytes,1,opt,name=domain" json:"domain,omitempty"`
-	CustomApi *MessageCustomApiExtensions `protobuf:"bytes,2,opt,name=custom_api,json=customApi" json:"custom_api,omitempty"`
-	Inbound   *MessageInboundExtensions   `protobuf:"bytes,3,opt,name=inbound" json:"inbound,omitempty"`
-	Outbound  *MessageOutboundExtensions  `protobuf:"bytes,4,opt,name=outbound" json:"outbound,omitempty"`
-	WireInput *MessageWireInputExtensions `protobuf:"bytes,5,opt,name=wire_input,json=wireInput" json:"wire_input,omitempty"`
+func (x *MikrosOneofExtensions) Reset() {
+	*x = MikrosOneofExtensions{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MikrosMessageExtensions) Reset() {
-	*x = MikrosMessageExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[17]
+func (x *MikrosOneofExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MikrosOneofExtensions) ProtoMessage() {}
+
+func (x *MikrosOneofExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MikrosOneofExtensions.ProtoReflect.Descriptor instead.
+func (*MikrosOneofExtensions) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MikrosOneofExtensions) GetValidate() *OneofValidateOptions {
+	if x != nil {
+		return x.Validate
+	}
+	return nil
+}
+
+type OneofValidateOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OneofRequired   *bool                  `protobuf:"varint,1,opt,name=oneof_required,json=oneofRequired" json:"oneof_required,omitempty"`
+	ErrorMessage    *string                `protobuf:"bytes,2,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+	ErrorMessageKey *string                `protobuf:"bytes,3,opt,name=error_message_key,json=errorMessageKey" json:"error_message_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OneofValidateOptions) Reset() {
+	*x = OneofValidateOptions{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneofValidateOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofValidateOptions) ProtoMessage() {}
+
+func (x *OneofValidateOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[19]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofValidateOptions.ProtoReflect.Descriptor instead.
+func (*OneofValidateOptions) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OneofValidateOptions) GetOneofRequired() bool {
+	if x != nil && x.OneofRequired != nil {
+		return *x.OneofRequired
 	}
+	return false
+}
+
+func (x *OneofValidateOptions) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *OneofValidateOptions) GetErrorMessageKey() string {
+	if x != nil && x.ErrorMessageKey != nil {
+		return *x.ErrorMessageKey
+	}
+	return ""
+}
+
+type MikrosMessageExtensions struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Domain        *MessageDomainExtensions    `protobuf:"bytes,1,opt,name=domain" json:"domain,omitempty"`
+	CustomApi     *MessageCustomApiExtensions `protobuf:"bytes,2,opt,name=custom_api,json=customApi" json:"custom_api,omitempty"`
+	Inbound       *MessageInboundExtensions   `protobuf:"bytes,3,opt,name=inbound" json:"inbound,omitempty"`
+	Outbound      *MessageOutboundExtensions  `protobuf:"bytes,4,opt,name=outbound" json:"outbound,omitempty"`
+	WireInput     *MessageWireInputExtensions `protobuf:"bytes,5,opt,name=wire_input,json=wireInput" json:"wire_input,omitempty"`
+	Database      *MessageDatabaseExtensions  `protobuf:"bytes,6,opt,name=database" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MikrosMessageExtensions) Reset() {
+	*x = MikrosMessageExtensions{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosMessageExtensions) String() string {
@@ -1359,8 +1913,8 @@ func (x *MikrosMessageExtensions) String() string {
 func (*MikrosMessageExtensions) ProtoMessage() {}
 
 func (x *MikrosMessageExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[20]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1372,7 +1926,7 @@ func (x *MikrosMessageExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MikrosMessageExtensions.ProtoReflect.Descriptor instead.
 func (*MikrosMessageExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{17}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MikrosMessageExtensions) GetDomain() *MessageDomainExtensions {
@@ -1410,22 +1964,26 @@ func (x *MikrosMessageExtensions) GetWireInput() *MessageWireInputExtensions {
 	return nil
 }
 
+func (x *MikrosMessageExtensions) GetDatabase() *MessageDatabaseExtensions {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
 type MessageDomainExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DontExport    *bool                  `protobuf:"varint,1,opt,name=dont_export,json=dontExport" json:"dont_export,omitempty"`
+	NamingMode    *NamingMode            `protobuf:"varint,2,opt,name=naming_mode,json=namingMode,enum=mikros.extensions.NamingMode" json:"naming_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	DontExport *bool       `protobuf:"varint,1,opt,name=dont_export,json=dontExport" json:"dont_export,omitempty"`
-	NamingMode *NamingMode `protobuf:"varint,2,opt,name=naming_mode,json=namingMode,enum=mikros.extensions.NamingMode" json:"naming_mode,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageDomainExtensions) Reset() {
 	*x = MessageDomainExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageDomainExtensions) String() string {
@@ -1435,8 +1993,8 @@ func (x *MessageDomainExtensions) String() string {
 func (*MessageDomainExtensions) ProtoMessage() {}
 
 func (x *MessageDomainExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[21]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1448,7 +2006,7 @@ func (x *MessageDomainExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageDomainExtensions.ProtoReflect.Descriptor instead.
 func (*MessageDomainExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{18}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MessageDomainExtensions) GetDontExport() bool {
@@ -1465,22 +2023,247 @@ func (x *MessageDomainExtensions) GetNamingMode() NamingMode {
 	return NamingMode_NAMING_MODE_SNAKE_CASE
 }
 
-type MessageCustomApiExtensions struct {
-	state         protoimpl.MessageState
+type MessageDatabaseExtensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         []*DatabaseIndex       `protobuf:"bytes,1,rep,name=index" json:"index,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	SoftDelete    *string                `protobuf:"bytes,3,opt,name=soft_delete,json=softDelete" json:"soft_delete,omitempty"`
+	CreatedAt     *string                `protobuf:"bytes,4,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	UpdatedAt     *string                `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	Kind          *string                `protobuf:"bytes,6,opt,name=kind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageDatabaseExtensions) Reset() {
+	*x = MessageDatabaseExtensions{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDatabaseExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDatabaseExtensions) ProtoMessage() {}
+
+func (x *MessageDatabaseExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDatabaseExtensions.ProtoReflect.Descriptor instead.
+func (*MessageDatabaseExtensions) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MessageDatabaseExtensions) GetIndex() []*DatabaseIndex {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *MessageDatabaseExtensions) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *MessageDatabaseExtensions) GetSoftDelete() string {
+	if x != nil && x.SoftDelete != nil {
+		return *x.SoftDelete
+	}
+	return ""
+}
+
+func (x *MessageDatabaseExtensions) GetCreatedAt() string {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MessageDatabaseExtensions) GetUpdatedAt() string {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *MessageDatabaseExtensions) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+type DatabaseIndex struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Field         []*DatabaseIndexField  `protobuf:"bytes,2,rep,name=field" json:"field,omitempty"`
+	Unique        *bool                  `protobuf:"varint,3,opt,name=unique" json:"unique,omitempty"`
+	Sparse        *bool                  `protobuf:"varint,4,opt,name=sparse" json:"sparse,omitempty"`
+	ExpireAfter   *string                `protobuf:"bytes,5,opt,name=expire_after,json=expireAfter" json:"expire_after,omitempty"`
+	PartialFilter *string                `protobuf:"bytes,6,opt,name=partial_filter,json=partialFilter" json:"partial_filter,omitempty"`
+	Where         *string                `protobuf:"bytes,7,opt,name=where" json:"where,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Function []*CustomFunctionExtensions `protobuf:"bytes,1,rep,name=function" json:"function,omitempty"`
-	Block    []string                    `protobuf:"bytes,2,rep,name=block" json:"block,omitempty"`
+func (x *DatabaseIndex) Reset() {
+	*x = DatabaseIndex{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MessageCustomApiExtensions) Reset() {
-	*x = MessageCustomApiExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[19]
+func (x *DatabaseIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseIndex) ProtoMessage() {}
+
+func (x *DatabaseIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseIndex.ProtoReflect.Descriptor instead.
+func (*DatabaseIndex) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DatabaseIndex) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseIndex) GetField() []*DatabaseIndexField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *DatabaseIndex) GetUnique() bool {
+	if x != nil && x.Unique != nil {
+		return *x.Unique
+	}
+	return false
+}
+
+func (x *DatabaseIndex) GetSparse() bool {
+	if x != nil && x.Sparse != nil {
+		return *x.Sparse
+	}
+	return false
+}
+
+func (x *DatabaseIndex) GetExpireAfter() string {
+	if x != nil && x.ExpireAfter != nil {
+		return *x.ExpireAfter
+	}
+	return ""
+}
+
+func (x *DatabaseIndex) GetPartialFilter() string {
+	if x != nil && x.PartialFilter != nil {
+		return *x.PartialFilter
+	}
+	return ""
+}
+
+func (x *DatabaseIndex) GetWhere() string {
+	if x != nil && x.Where != nil {
+		return *x.Where
+	}
+	return ""
+}
+
+type DatabaseIndexField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Descending    *bool                  `protobuf:"varint,2,opt,name=descending" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseIndexField) Reset() {
+	*x = DatabaseIndexField{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseIndexField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseIndexField) ProtoMessage() {}
+
+func (x *DatabaseIndexField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[24]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseIndexField.ProtoReflect.Descriptor instead.
+func (*DatabaseIndexField) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DatabaseIndexField) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseIndexField) GetDescending() bool {
+	if x != nil && x.Descending != nil {
+		return *x.Descending
 	}
+	return false
+}
+
+type MessageCustomApiExtensions struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Function      []*CustomFunctionExtensions `protobuf:"bytes,1,rep,name=function" json:"function,omitempty"`
+	Block         []string                    `protobuf:"bytes,2,rep,name=block" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageCustomApiExtensions) Reset() {
+	*x = MessageCustomApiExtensions{}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageCustomApiExtensions) String() string {
@@ -1490,8 +2273,8 @@ func (x *MessageCustomApiExtensions) String() string {
 func (*MessageCustomApiExtensions) ProtoMessage() {}
 
 func (x *MessageCustomApiExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[25]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1503,7 +2286,7 @@ func (x *MessageCustomApiExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageCustomApiExtensions.ProtoReflect.Descriptor instead.
 func (*MessageCustomApiExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{19}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MessageCustomApiExtensions) GetFunction() []*CustomFunctionExtensions {
@@ -1521,22 +2304,19 @@ func (x *MessageCustomApiExtensions) GetBlock() []string {
 }
 
 type CustomFunctionExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     *string                `protobuf:"bytes,1,req,name=signature" json:"signature,omitempty"`
+	Body          *string                `protobuf:"bytes,2,req,name=body" json:"body,omitempty"`
+	Import        []*MikrosCustomImport  `protobuf:"bytes,3,rep,name=import" json:"import,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Signature *string               `protobuf:"bytes,1,req,name=signature" json:"signature,omitempty"`
-	Body      *string               `protobuf:"bytes,2,req,name=body" json:"body,omitempty"`
-	Import    []*MikrosCustomImport `protobuf:"bytes,3,rep,name=import" json:"import,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CustomFunctionExtensions) Reset() {
 	*x = CustomFunctionExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CustomFunctionExtensions) String() string {
@@ -1546,8 +2326,8 @@ func (x *CustomFunctionExtensions) String() string {
 func (*CustomFunctionExtensions) ProtoMessage() {}
 
 func (x *CustomFunctionExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[26]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1559,7 +2339,7 @@ func (x *CustomFunctionExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomFunctionExtensions.ProtoReflect.Descriptor instead.
 func (*CustomFunctionExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{20}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CustomFunctionExtensions) GetSignature() string {
@@ -1584,21 +2364,18 @@ func (x *CustomFunctionExtensions) GetImport() []*MikrosCustomImport {
 }
 
 type MikrosCustomImport struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         *string                `protobuf:"bytes,1,opt,name=alias" json:"alias,omitempty"`
+	Name          *string                `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Alias *string `protobuf:"bytes,1,opt,name=alias" json:"alias,omitempty"`
-	Name  *string `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MikrosCustomImport) Reset() {
 	*x = MikrosCustomImport{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MikrosCustomImport) String() string {
@@ -1608,8 +2385,8 @@ func (x *MikrosCustomImport) String() string {
 func (*MikrosCustomImport) ProtoMessage() {}
 
 func (x *MikrosCustomImport) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[27]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1621,7 +2398,7 @@ func (x *MikrosCustomImport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MikrosCustomImport.ProtoReflect.Descriptor instead.
 func (*MikrosCustomImport) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{21}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MikrosCustomImport) GetAlias() string {
@@ -1639,20 +2416,17 @@ func (x *MikrosCustomImport) GetName() string {
 }
 
 type MessageInboundExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamingMode    *NamingMode            `protobuf:"varint,1,opt,name=naming_mode,json=namingMode,enum=mikros.extensions.NamingMode" json:"naming_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NamingMode *NamingMode `protobuf:"varint,1,opt,name=naming_mode,json=namingMode,enum=mikros.extensions.NamingMode" json:"naming_mode,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageInboundExtensions) Reset() {
 	*x = MessageInboundExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageInboundExtensions) String() string {
@@ -1662,8 +2436,8 @@ func (x *MessageInboundExtensions) String() string {
 func (*MessageInboundExtensions) ProtoMessage() {}
 
 func (x *MessageInboundExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[28]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1675,7 +2449,7 @@ func (x *MessageInboundExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageInboundExtensions.ProtoReflect.Descriptor instead.
 func (*MessageInboundExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{22}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MessageInboundExtensions) GetNamingMode() NamingMode {
@@ -1686,21 +2460,18 @@ func (x *MessageInboundExtensions) GetNamingMode() NamingMode {
 }
 
 type MessageOutboundExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *bool                  `protobuf:"varint,1,opt,name=export" json:"export,omitempty"`
+	NamingMode    *NamingMode            `protobuf:"varint,2,opt,name=naming_mode,json=namingMode,enum=mikros.extensions.NamingMode" json:"naming_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Export     *bool       `protobuf:"varint,1,opt,name=export" json:"export,omitempty"`
-	NamingMode *NamingMode `protobuf:"varint,2,opt,name=naming_mode,json=namingMode,enum=mikros.extensions.NamingMode" json:"naming_mode,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageOutboundExtensions) Reset() {
 	*x = MessageOutboundExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageOutboundExtensions) String() string {
@@ -1710,8 +2481,8 @@ func (x *MessageOutboundExtensions) String() string {
 func (*MessageOutboundExtensions) ProtoMessage() {}
 
 func (x *MessageOutboundExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[29]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1723,7 +2494,7 @@ func (x *MessageOutboundExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageOutboundExtensions.ProtoReflect.Descriptor instead.
 func (*MessageOutboundExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{23}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *MessageOutboundExtensions) GetExport() bool {
@@ -1741,20 +2512,17 @@ func (x *MessageOutboundExtensions) GetNamingMode() NamingMode {
 }
 
 type MessageWireInputExtensions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *bool                  `protobuf:"varint,1,opt,name=export" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Export *bool `protobuf:"varint,1,opt,name=export" json:"export,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageWireInputExtensions) Reset() {
 	*x = MessageWireInputExtensions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_extensions_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_mikros_extensions_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageWireInputExtensions) String() string {
@@ -1764,8 +2532,8 @@ func (x *MessageWireInputExtensions) String() string {
 func (*MessageWireInputExtensions) ProtoMessage() {}
 
 func (x *MessageWireInputExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_extensions_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_mikros_extensions_proto_msgTypes[30]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1777,7 +2545,7 @@ func (x *MessageWireInputExtensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageWireInputExtensions.ProtoReflect.Descriptor instead.
 func (*MessageWireInputExtensions) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{24}
+	return file_proto_mikros_extensions_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MessageWireInputExtensions) GetExport() bool {
@@ -1828,6 +2596,14 @@ var file_proto_mikros_extensions_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,85042,opt,name=field_options",
 		Filename:      "proto/mikros_extensions.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
+		ExtensionType: (*MikrosOneofExtensions)(nil),
+		Field:         85042,
+		Name:          "mikros.extensions.oneof_options",
+		Tag:           "bytes,85042,opt,name=oneof_options",
+		Filename:      "proto/mikros_extensions.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
 		ExtensionType: (*MikrosMessageExtensions)(nil),
@@ -1868,423 +2644,367 @@ var (
 	E_FieldOptions = &file_proto_mikros_extensions_proto_extTypes[4]
 )
 
+// Extension fields to descriptorpb.OneofOptions.
+var (
+	// optional mikros.extensions.MikrosOneofExtensions oneof_options = 85042;
+	E_OneofOptions = &file_proto_mikros_extensions_proto_extTypes[5]
+)
+
 // Extension fields to descriptorpb.MessageOptions.
 var (
 	// optional mikros.extensions.MikrosMessageExtensions message_options = 85042;
-	E_MessageOptions = &file_proto_mikros_extensions_proto_extTypes[5]
+	E_MessageOptions = &file_proto_mikros_extensions_proto_extTypes[6]
 )
 
 var File_proto_mikros_extensions_proto protoreflect.FileDescriptor
 
-var file_proto_mikros_extensions_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x5f, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x11, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x17, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x54, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x1b, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x28, 0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x16, 0x4d, 0x69, 0x6b,
-	0x72, 0x6f, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70,
-	0x22, 0x82, 0x01, 0x0a, 0x14, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61, 0x72, 0x67, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x41, 0x72, 0x67, 0x12, 0x37, 0x0a, 0x18,
-	0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
-	0x70, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x4e, 0x0a, 0x14, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x45,
-	0x6e, 0x75, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a,
-	0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6b,
-	0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x41, 0x70, 0x69, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x03, 0x61, 0x70, 0x69, 0x22, 0x4c, 0x0a, 0x11, 0x45, 0x6e, 0x75, 0x6d, 0x41, 0x70, 0x69,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69,
-	0x74, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x69, 0x74,
-	0x66, 0x6c, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x19, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x45, 0x6e, 0x75,
-	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x32, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x22, 0x1f, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x03, 0x0a, 0x15, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x3d, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x43,
-	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73,
-	0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x08, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d,
-	0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x40, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a,
-	0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x54, 0x61, 0x67, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x22,
-	0x3a, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x14,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61,
-	0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xf2, 0x02,
-	0x0a, 0x14, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x69, 0x64, 0x65, 0x12, 0x41,
-	0x0a, 0x07, 0x62, 0x69, 0x74, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x69, 0x74, 0x66,
-	0x6c, 0x61, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x62, 0x69, 0x74, 0x66, 0x6c, 0x61,
-	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x54, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x62,
-	0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x22, 0x46, 0x0a, 0x14, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x69,
-	0x74, 0x66, 0x6c, 0x61, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x9a, 0x04, 0x0a, 0x14, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
-	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x69, 0x76, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x49, 0x66,
-	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x5f,
-	0x6e, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x12, 0x29, 0x0a,
-	0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75,
-	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6e, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x53, 0x0a, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x54, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x22, 0x8a, 0x03, 0x0a,
-	0x17, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f,
-	0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0a,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x41, 0x70, 0x69, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70, 0x69, 0x12, 0x45, 0x0a, 0x07, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69,
-	0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
-	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x4c, 0x0a, 0x0a, 0x77,
-	0x69, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x72, 0x65, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09,
-	0x77, 0x69, 0x72, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x7a, 0x0a, 0x17, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x6f, 0x6e, 0x74, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6b,
-	0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x7b, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70, 0x69, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x22, 0x3e, 0x0a, 0x12, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x5a, 0x0a, 0x18, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0b,
-	0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x73, 0x0a, 0x19,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
-	0x65, 0x22, 0x34, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x72, 0x65,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2a, 0x52, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a,
-	0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
-	0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x2a, 0x7b, 0x0a, 0x12, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6c,
-	0x65, 0x12, 0x24, 0x0a, 0x20, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x49, 0x45, 0x4c, 0x44,
-	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f,
-	0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x45, 0x4c, 0x44,
-	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x41, 0x4d, 0x49, 0x4e, 0x47,
-	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x4b, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x45,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x43, 0x41, 0x4d, 0x45, 0x4c, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x10, 0x01, 0x3a, 0x76,
-	0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0xb2, 0x98, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6b,
-	0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d,
-	0x69, 0x6b, 0x72, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x72, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb2, 0x98, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x6a, 0x0a, 0x0c, 0x65, 0x6e,
-	0x75, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb2, 0x98, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x7f, 0x0a, 0x12, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0xb2, 0x98, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73,
-	0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72,
-	0x6f, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x10, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x6e, 0x0a, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb2, 0x98, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x76, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb2, 0x98, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
-	0x57, 0x5a, 0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69,
-	0x6b, 0x72, 0x6f, 0x73, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2d, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3b, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-}
+const file_proto_mikros_extensions_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/mikros_extensions.proto\x12\x11mikros.extensions\x1a google/protobuf/descriptor.proto\"o\n" +
+	"\x17MikrosServiceExtensions\x12T\n" +
+	"\rauthorization\x18\x01 \x01(\v2..mikros.extensions.HttpAuthorizationExtensionsR\rauthorization\"\x81\x01\n" +
+	"\x1bHttpAuthorizationExtensions\x128\n" +
+	"\x04mode\x18\x01 \x02(\x0e2$.mikros.extensions.AuthorizationModeR\x04mode\x12(\n" +
+	"\x10custom_auth_name\x18\x02 \x01(\tR\x0ecustomAuthName\"U\n" +
+	"\x16MikrosMethodExtensions\x12;\n" +
+	"\x04http\x18\x01 \x01(\v2'.mikros.extensions.HttpMethodExtensionsR\x04http\"\x82\x01\n" +
+	"\x14HttpMethodExtensions\x12\x16\n" +
+	"\x06header\x18\x01 \x03(\tR\x06header\x12\x19\n" +
+	"\bauth_arg\x18\x02 \x03(\tR\aauthArg\x127\n" +
+	"\x18parse_request_in_service\x18\x03 \x01(\bR\x15parseRequestInService\"N\n" +
+	"\x14MikrosEnumExtensions\x126\n" +
+	"\x03api\x18\x01 \x01(\v2$.mikros.extensions.EnumApiExtensionsR\x03api\"L\n" +
+	"\x11EnumApiExtensions\x12\x18\n" +
+	"\abitflag\x18\x01 \x01(\bR\abitflag\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\bR\terrorCode\"O\n" +
+	"\x19MikrosEnumValueExtensions\x122\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1c.mikros.extensions.EnumEntryR\x05entry\"\x1f\n" +
+	"\tEnumEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xa9\x03\n" +
+	"\x15MikrosFieldExtensions\x12=\n" +
+	"\x06domain\x18\x01 \x01(\v2%.mikros.extensions.FieldDomainOptionsR\x06domain\x12C\n" +
+	"\bdatabase\x18\x02 \x01(\v2'.mikros.extensions.FieldDatabaseOptionsR\bdatabase\x12@\n" +
+	"\ainbound\x18\x03 \x01(\v2&.mikros.extensions.FieldInboundOptionsR\ainbound\x12C\n" +
+	"\boutbound\x18\x04 \x01(\v2'.mikros.extensions.FieldOutboundOptionsR\boutbound\x12C\n" +
+	"\bvalidate\x18\x05 \x01(\v2'.mikros.extensions.FieldValidateOptionsR\bvalidate\x12@\n" +
+	"\atesting\x18\x06 \x01(\v2&.mikros.extensions.FieldTestingOptionsR\atesting\"\xb0\x01\n" +
+	"\x12FieldDomainOptions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vallow_empty\x18\x02 \x01(\bR\n" +
+	"allowEmpty\x12@\n" +
+	"\n" +
+	"struct_tag\x18\x03 \x03(\v2!.mikros.extensions.FieldStructTagR\tstructTag\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\":\n" +
+	"\x0eFieldStructTag\x12\x12\n" +
+	"\x04name\x18\x01 \x02(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x02(\tR\x05value\"\xe0\x05\n" +
+	"\x14FieldDatabaseOptions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vallow_empty\x18\x02 \x01(\bR\n" +
+	"allowEmpty\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\bR\x05index\x12\x16\n" +
+	"\x06unique\x18\x04 \x01(\bR\x06unique\x12!\n" +
+	"\funique_index\x18\x05 \x01(\bR\vuniqueIndex\x12\x1f\n" +
+	"\vprimary_key\x18\x06 \x01(\bR\n" +
+	"primaryKey\x12%\n" +
+	"\x0eauto_increment\x18\a \x01(\bR\rautoIncrement\x12!\n" +
+	"\fexpire_after\x18\b \x01(\tR\vexpireAfter\x12%\n" +
+	"\x0epartial_filter\x18\t \x01(\tR\rpartialFilter\x12#\n" +
+	"\rpartition_key\x18\n" +
+	" \x01(\bR\fpartitionKey\x12\x19\n" +
+	"\bsort_key\x18\v \x01(\bR\asortKey\x12I\n" +
+	"\fstorage_type\x18\f \x01(\x0e2&.mikros.extensions.DatabaseStorageTypeR\vstorageType\x12\x1a\n" +
+	"\bembedded\x18\r \x01(\bR\bembedded\x12'\n" +
+	"\x0fembedded_prefix\x18\x0e \x01(\tR\x0eembeddedPrefix\x12?\n" +
+	"\brelation\x18\x0f \x01(\v2#.mikros.extensions.DatabaseRelationR\brelation\x12E\n" +
+	"\n" +
+	"serializer\x18\x10 \x01(\x0e2%.mikros.extensions.DatabaseSerializerR\n" +
+	"serializer\x12\x1f\n" +
+	"\vcolumn_type\x18\x11 \x01(\tR\n" +
+	"columnType\x12\x12\n" +
+	"\x04size\x18\x12 \x01(\x05R\x04size\x12#\n" +
+	"\rdefault_value\x18\x13 \x01(\tR\fdefaultValue\"\x90\x01\n" +
+	"\x10DatabaseRelation\x12;\n" +
+	"\x04kind\x18\x01 \x01(\x0e2'.mikros.extensions.DatabaseRelationKindR\x04kind\x12\x1f\n" +
+	"\vforeign_key\x18\x02 \x01(\tR\n" +
+	"foreignKey\x12\x1e\n" +
+	"\n" +
+	"references\x18\x03 \x01(\tR\n" +
+	"references\")\n" +
+	"\x13FieldInboundOptions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xbb\x03\n" +
+	"\x14FieldOutboundOptions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hide\x18\x02 \x01(\bR\x04hide\x12A\n" +
+	"\abitflag\x18\x03 \x01(\v2'.mikros.extensions.OutboundBitflagFieldR\abitflag\x12\x1f\n" +
+	"\vallow_empty\x18\x04 \x01(\bR\n" +
+	"allowEmpty\x12@\n" +
+	"\n" +
+	"struct_tag\x18\x05 \x03(\v2!.mikros.extensions.FieldStructTagR\tstructTag\x12\x1f\n" +
+	"\vcustom_bind\x18\x06 \x01(\bR\n" +
+	"customBind\x12\x1f\n" +
+	"\vcustom_type\x18\a \x01(\tR\n" +
+	"customType\x12J\n" +
+	"\rcustom_import\x18\b \x01(\v2%.mikros.extensions.MikrosCustomImportR\fcustomImport\x12G\n" +
+	"\x0ebytes_encoding\x18\t \x01(\x0e2 .mikros.extensions.BytesEncodingR\rbytesEncoding\"F\n" +
+	"\x14OutboundBitflagField\x12\x16\n" +
+	"\x06values\x18\x01 \x02(\tR\x06values\x12\x16\n" +
+	"\x06prefix\x18\x02 \x02(\tR\x06prefix\"\x86\a\n" +
+	"\x14FieldValidateOptions\x129\n" +
+	"\x04rule\x18\x01 \x01(\x0e2%.mikros.extensions.FieldValidatorRuleR\x04rule\x12\x1b\n" +
+	"\trule_args\x18\x02 \x03(\tR\bruleArgs\x12\x1f\n" +
+	"\vcustom_rule\x18\x03 \x01(\tR\n" +
+	"customRule\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x10\n" +
+	"\x03min\x18\x05 \x01(\x05R\x03min\x12\x10\n" +
+	"\x03max\x18\x06 \x01(\x05R\x03max\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\a \x01(\x05R\tmaxLength\x12\x12\n" +
+	"\x04dive\x18\b \x01(\bR\x04dive\x12\x1f\n" +
+	"\vrequired_if\x18\t \x01(\tR\n" +
+	"requiredIf\x12&\n" +
+	"\x0frequired_if_not\x18\n" +
+	" \x01(\tR\rrequiredIfNot\x12#\n" +
+	"\rrequired_with\x18\v \x01(\tR\frequiredWith\x12)\n" +
+	"\x10required_without\x18\f \x01(\tR\x0frequiredWithout\x12!\n" +
+	"\frequired_all\x18\r \x01(\tR\vrequiredAll\x12!\n" +
+	"\frequired_any\x18\x0e \x01(\tR\vrequiredAny\x12#\n" +
+	"\rerror_message\x18\x0f \x01(\tR\ferrorMessage\x12\x12\n" +
+	"\x04skip\x18\x10 \x01(\bR\x04skip\x12*\n" +
+	"\x11error_message_key\x18\x11 \x01(\tR\x0ferrorMessageKey\x12;\n" +
+	"\x04keys\x18\x12 \x01(\v2'.mikros.extensions.FieldValidateOptionsR\x04keys\x12?\n" +
+	"\x06values\x18\x13 \x01(\v2'.mikros.extensions.FieldValidateOptionsR\x06values\x12\x15\n" +
+	"\x06lt_now\x18\x14 \x01(\bR\x05ltNow\x12\x15\n" +
+	"\x06gt_now\x18\x15 \x01(\bR\x05gtNow\x12\x16\n" +
+	"\x06within\x18\x16 \x01(\tR\x06within\x12\x19\n" +
+	"\blt_field\x18\x17 \x01(\tR\altField\x12\x19\n" +
+	"\bgt_field\x18\x18 \x01(\tR\agtField\x12!\n" +
+	"\fmin_duration\x18\x19 \x01(\tR\vminDuration\x12!\n" +
+	"\fmax_duration\x18\x1a \x01(\tR\vmaxDuration\"S\n" +
+	"\x13FieldTestingOptions\x12\x1f\n" +
+	"\vcustom_rule\x18\x01 \x02(\tR\n" +
+	"customRule\x12\x1b\n" +
+	"\trule_args\x18\x02 \x03(\tR\bruleArgs\"\\\n" +
+	"\x15MikrosOneofExtensions\x12C\n" +
+	"\bvalidate\x18\x01 \x01(\v2'.mikros.extensions.OneofValidateOptionsR\bvalidate\"\x8e\x01\n" +
+	"\x14OneofValidateOptions\x12%\n" +
+	"\x0eoneof_required\x18\x01 \x01(\bR\roneofRequired\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12*\n" +
+	"\x11error_message_key\x18\x03 \x01(\tR\x0ferrorMessageKey\"\xd4\x03\n" +
+	"\x17MikrosMessageExtensions\x12B\n" +
+	"\x06domain\x18\x01 \x01(\v2*.mikros.extensions.MessageDomainExtensionsR\x06domain\x12L\n" +
+	"\n" +
+	"custom_api\x18\x02 \x01(\v2-.mikros.extensions.MessageCustomApiExtensionsR\tcustomApi\x12E\n" +
+	"\ainbound\x18\x03 \x01(\v2+.mikros.extensions.MessageInboundExtensionsR\ainbound\x12H\n" +
+	"\boutbound\x18\x04 \x01(\v2,.mikros.extensions.MessageOutboundExtensionsR\boutbound\x12L\n" +
+	"\n" +
+	"wire_input\x18\x05 \x01(\v2-.mikros.extensions.MessageWireInputExtensionsR\twireInput\x12H\n" +
+	"\bdatabase\x18\x06 \x01(\v2,.mikros.extensions.MessageDatabaseExtensionsR\bdatabase\"z\n" +
+	"\x17MessageDomainExtensions\x12\x1f\n" +
+	"\vdont_export\x18\x01 \x01(\bR\n" +
+	"dontExport\x12>\n" +
+	"\vnaming_mode\x18\x02 \x01(\x0e2\x1d.mikros.extensions.NamingModeR\n" +
+	"namingMode\"\xda\x01\n" +
+	"\x19MessageDatabaseExtensions\x126\n" +
+	"\x05index\x18\x01 \x03(\v2 .mikros.extensions.DatabaseIndexR\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vsoft_delete\x18\x03 \x01(\tR\n" +
+	"softDelete\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\"\xf0\x01\n" +
+	"\rDatabaseIndex\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\x05field\x18\x02 \x03(\v2%.mikros.extensions.DatabaseIndexFieldR\x05field\x12\x16\n" +
+	"\x06unique\x18\x03 \x01(\bR\x06unique\x12\x16\n" +
+	"\x06sparse\x18\x04 \x01(\bR\x06sparse\x12!\n" +
+	"\fexpire_after\x18\x05 \x01(\tR\vexpireAfter\x12%\n" +
+	"\x0epartial_filter\x18\x06 \x01(\tR\rpartialFilter\x12\x14\n" +
+	"\x05where\x18\a \x01(\tR\x05where\"H\n" +
+	"\x12DatabaseIndexField\x12\x12\n" +
+	"\x04name\x18\x01 \x02(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x02 \x01(\bR\n" +
+	"descending\"{\n" +
+	"\x1aMessageCustomApiExtensions\x12G\n" +
+	"\bfunction\x18\x01 \x03(\v2+.mikros.extensions.CustomFunctionExtensionsR\bfunction\x12\x14\n" +
+	"\x05block\x18\x02 \x03(\tR\x05block\"\x8b\x01\n" +
+	"\x18CustomFunctionExtensions\x12\x1c\n" +
+	"\tsignature\x18\x01 \x02(\tR\tsignature\x12\x12\n" +
+	"\x04body\x18\x02 \x02(\tR\x04body\x12=\n" +
+	"\x06import\x18\x03 \x03(\v2%.mikros.extensions.MikrosCustomImportR\x06import\">\n" +
+	"\x12MikrosCustomImport\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x12\n" +
+	"\x04name\x18\x02 \x02(\tR\x04name\"Z\n" +
+	"\x18MessageInboundExtensions\x12>\n" +
+	"\vnaming_mode\x18\x01 \x01(\x0e2\x1d.mikros.extensions.NamingModeR\n" +
+	"namingMode\"s\n" +
+	"\x19MessageOutboundExtensions\x12\x16\n" +
+	"\x06export\x18\x01 \x01(\bR\x06export\x12>\n" +
+	"\vnaming_mode\x18\x02 \x01(\x0e2\x1d.mikros.extensions.NamingModeR\n" +
+	"namingMode\"4\n" +
+	"\x1aMessageWireInputExtensions\x12\x16\n" +
+	"\x06export\x18\x01 \x01(\bR\x06export*R\n" +
+	"\x11AuthorizationMode\x12\x1e\n" +
+	"\x1aAUTHORIZATION_MODE_NO_AUTH\x10\x00\x12\x1d\n" +
+	"\x19AUTHORIZATION_MODE_CUSTOM\x10\x01*\xa7\x01\n" +
+	"\x13DatabaseStorageType\x12%\n" +
+	"!DATABASE_STORAGE_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fDATABASE_STORAGE_TYPE_OBJECT_ID\x10\x01\x12$\n" +
+	" DATABASE_STORAGE_TYPE_DECIMAL128\x10\x02\x12\x1e\n" +
+	"\x1aDATABASE_STORAGE_TYPE_DATE\x10\x03*\xae\x01\n" +
+	"\x14DatabaseRelationKind\x12&\n" +
+	"\"DATABASE_RELATION_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eDATABASE_RELATION_KIND_HAS_ONE\x10\x01\x12#\n" +
+	"\x1fDATABASE_RELATION_KIND_HAS_MANY\x10\x02\x12%\n" +
+	"!DATABASE_RELATION_KIND_BELONGS_TO\x10\x03*v\n" +
+	"\x12DatabaseSerializer\x12#\n" +
+	"\x1fDATABASE_SERIALIZER_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DATABASE_SERIALIZER_JSON\x10\x01\x12\x1d\n" +
+	"\x19DATABASE_SERIALIZER_JSONB\x10\x02*\x99\x01\n" +
+	"\rBytesEncoding\x12\x1e\n" +
+	"\x1aBYTES_ENCODING_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15BYTES_ENCODING_BASE64\x10\x01\x12\x1d\n" +
+	"\x19BYTES_ENCODING_BASE64_URL\x10\x02\x12\x16\n" +
+	"\x12BYTES_ENCODING_HEX\x10\x03\x12\x16\n" +
+	"\x12BYTES_ENCODING_RAW\x10\x04*{\n" +
+	"\x12FieldValidatorRule\x12$\n" +
+	" FIELD_VALIDATOR_RULE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFIELD_VALIDATOR_RULE_REGEX\x10\x01\x12\x1f\n" +
+	"\x1bFIELD_VALIDATOR_RULE_CUSTOM\x10\x02*D\n" +
+	"\n" +
+	"NamingMode\x12\x1a\n" +
+	"\x16NAMING_MODE_SNAKE_CASE\x10\x00\x12\x1a\n" +
+	"\x16NAMING_MODE_CAMEL_CASE\x10\x01:v\n" +
+	"\x0fservice_options\x12\x1f.google.protobuf.ServiceOptions\x18\xb2\x98\x05 \x01(\v2*.mikros.extensions.MikrosServiceExtensionsR\x0eserviceOptions:r\n" +
+	"\x0emethod_options\x12\x1e.google.protobuf.MethodOptions\x18\xb2\x98\x05 \x01(\v2).mikros.extensions.MikrosMethodExtensionsR\rmethodOptions:j\n" +
+	"\fenum_options\x12\x1c.google.protobuf.EnumOptions\x18\xb2\x98\x05 \x01(\v2'.mikros.extensions.MikrosEnumExtensionsR\venumOptions:\x7f\n" +
+	"\x12enum_value_options\x12!.google.protobuf.EnumValueOptions\x18\xb2\x98\x05 \x01(\v2,.mikros.extensions.MikrosEnumValueExtensionsR\x10enumValueOptions:n\n" +
+	"\rfield_options\x12\x1d.google.protobuf.FieldOptions\x18\xb2\x98\x05 \x01(\v2(.mikros.extensions.MikrosFieldExtensionsR\ffieldOptions:n\n" +
+	"\roneof_options\x12\x1d.google.protobuf.OneofOptions\x18\xb2\x98\x05 \x01(\v2(.mikros.extensions.MikrosOneofExtensionsR\foneofOptions:v\n" +
+	"\x0fmessage_options\x12\x1f.google.protobuf.MessageOptions\x18\xb2\x98\x05 \x01(\v2*.mikros.extensions.MikrosMessageExtensionsR\x0emessageOptionsBWZUgithub.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions;extensions"
 
 var (
 	file_proto_mikros_extensions_proto_rawDescOnce sync.Once
-	file_proto_mikros_extensions_proto_rawDescData = file_proto_mikros_extensions_proto_rawDesc
+	file_proto_mikros_extensions_proto_rawDescData []byte
 )
 
 func file_proto_mikros_extensions_proto_rawDescGZIP() []byte {
 	file_proto_mikros_extensions_proto_rawDescOnce.Do(func() {
-		file_proto_mikros_extensions_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_mikros_extensions_proto_rawDescData)
+		file_proto_mikros_extensions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_mikros_extensions_proto_rawDesc), len(file_proto_mikros_extensions_proto_rawDesc)))
 	})
 	return file_proto_mikros_extensions_proto_rawDescData
 }
 
-var file_proto_mikros_extensions_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_mikros_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_proto_mikros_extensions_proto_goTypes = []interface{}{
+var file_proto_mikros_extensions_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_mikros_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_proto_mikros_extensions_proto_goTypes = []any{
 	(AuthorizationMode)(0),                // 0: mikros.extensions.AuthorizationMode
-	(FieldValidatorRule)(0),               // 1: mikros.extensions.FieldValidatorRule
-	(NamingMode)(0),                       // 2: mikros.extensions.NamingMode
-	(*MikrosServiceExtensions)(nil),       // 3: mikros.extensions.MikrosServiceExtensions
-	(*HttpAuthorizationExtensions)(nil),   // 4: mikros.extensions.HttpAuthorizationExtensions
-	(*MikrosMethodExtensions)(nil),        // 5: mikros.extensions.MikrosMethodExtensions
-	(*HttpMethodExtensions)(nil),          // 6: mikros.extensions.HttpMethodExtensions
-	(*MikrosEnumExtensions)(nil),          // 7: mikros.extensions.MikrosEnumExtensions
-	(*EnumApiExtensions)(nil),             // 8: mikros.extensions.EnumApiExtensions
-	(*MikrosEnumValueExtensions)(nil),     // 9: mikros.extensions.MikrosEnumValueExtensions
-	(*EnumEntry)(nil),                     // 10: mikros.extensions.EnumEntry
-	(*MikrosFieldExtensions)(nil),         // 11: mikros.extensions.MikrosFieldExtensions
-	(*FieldDomainOptions)(nil),            // 12: mikros.extensions.FieldDomainOptions
-	(*FieldStructTag)(nil),                // 13: mikros.extensions.FieldStructTag
-	(*FieldDatabaseOptions)(nil),          // 14: mikros.extensions.FieldDatabaseOptions
-	(*FieldInboundOptions)(nil),           // 15: mikros.extensions.FieldInboundOptions
-	(*FieldOutboundOptions)(nil),          // 16: mikros.extensions.FieldOutboundOptions
-	(*OutboundBitflagField)(nil),          // 17: mikros.extensions.OutboundBitflagField
-	(*FieldValidateOptions)(nil),          // 18: mikros.extensions.FieldValidateOptions
-	(*FieldTestingOptions)(nil),           // 19: mikros.extensions.FieldTestingOptions
-	(*MikrosMessageExtensions)(nil),       // 20: mikros.extensions.MikrosMessageExtensions
-	(*MessageDomainExtensions)(nil),       // 21: mikros.extensions.MessageDomainExtensions
-	(*MessageCustomApiExtensions)(nil),    // 22: mikros.extensions.MessageCustomApiExtensions
-	(*CustomFunctionExtensions)(nil),      // 23: mikros.extensions.CustomFunctionExtensions
-	(*MikrosCustomImport)(nil),            // 24: mikros.extensions.MikrosCustomImport
-	(*MessageInboundExtensions)(nil),      // 25: mikros.extensions.MessageInboundExtensions
-	(*MessageOutboundExtensions)(nil),     // 26: mikros.extensions.MessageOutboundExtensions
-	(*MessageWireInputExtensions)(nil),    // 27: mikros.extensions.MessageWireInputExtensions
-	(*descriptorpb.ServiceOptions)(nil),   // 28: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),    // 29: google.protobuf.MethodOptions
-	(*descriptorpb.EnumOptions)(nil),      // 30: google.protobuf.EnumOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 31: google.protobuf.EnumValueOptions
-	(*descriptorpb.FieldOptions)(nil),     // 32: google.protobuf.FieldOptions
-	(*descriptorpb.MessageOptions)(nil),   // 33: google.protobuf.MessageOptions
+	(DatabaseStorageType)(0),              // 1: mikros.extensions.DatabaseStorageType
+	(DatabaseRelationKind)(0),             // 2: mikros.extensions.DatabaseRelationKind
+	(DatabaseSerializer)(0),               // 3: mikros.extensions.DatabaseSerializer
+	(BytesEncoding)(0),                    // 4: mikros.extensions.BytesEncoding
+	(FieldValidatorRule)(0),               // 5: mikros.extensions.FieldValidatorRule
+	(NamingMode)(0),                       // 6: mikros.extensions.NamingMode
+	(*MikrosServiceExtensions)(nil),       // 7: mikros.extensions.MikrosServiceExtensions
+	(*HttpAuthorizationExtensions)(nil),   // 8: mikros.extensions.HttpAuthorizationExtensions
+	(*MikrosMethodExtensions)(nil),        // 9: mikros.extensions.MikrosMethodExtensions
+	(*HttpMethodExtensions)(nil),          // 10: mikros.extensions.HttpMethodExtensions
+	(*MikrosEnumExtensions)(nil),          // 11: mikros.extensions.MikrosEnumExtensions
+	(*EnumApiExtensions)(nil),             // 12: mikros.extensions.EnumApiExtensions
+	(*MikrosEnumValueExtensions)(nil),     // 13: mikros.extensions.MikrosEnumValueExtensions
+	(*EnumEntry)(nil),                     // 14: mikros.extensions.EnumEntry
+	(*MikrosFieldExtensions)(nil),         // 15: mikros.extensions.MikrosFieldExtensions
+	(*FieldDomainOptions)(nil),            // 16: mikros.extensions.FieldDomainOptions
+	(*FieldStructTag)(nil),                // 17: mikros.extensions.FieldStructTag
+	(*FieldDatabaseOptions)(nil),          // 18: mikros.extensions.FieldDatabaseOptions
+	(*DatabaseRelation)(nil),              // 19: mikros.extensions.DatabaseRelation
+	(*FieldInboundOptions)(nil),           // 20: mikros.extensions.FieldInboundOptions
+	(*FieldOutboundOptions)(nil),          // 21: mikros.extensions.FieldOutboundOptions
+	(*OutboundBitflagField)(nil),          // 22: mikros.extensions.OutboundBitflagField
+	(*FieldValidateOptions)(nil),          // 23: mikros.extensions.FieldValidateOptions
+	(*FieldTestingOptions)(nil),           // 24: mikros.extensions.FieldTestingOptions
+	(*MikrosOneofExtensions)(nil),         // 25: mikros.extensions.MikrosOneofExtensions
+	(*OneofValidateOptions)(nil),          // 26: mikros.extensions.OneofValidateOptions
+	(*MikrosMessageExtensions)(nil),       // 27: mikros.extensions.MikrosMessageExtensions
+	(*MessageDomainExtensions)(nil),       // 28: mikros.extensions.MessageDomainExtensions
+	(*MessageDatabaseExtensions)(nil),     // 29: mikros.extensions.MessageDatabaseExtensions
+	(*DatabaseIndex)(nil),                 // 30: mikros.extensions.DatabaseIndex
+	(*DatabaseIndexField)(nil),            // 31: mikros.extensions.DatabaseIndexField
+	(*MessageCustomApiExtensions)(nil),    // 32: mikros.extensions.MessageCustomApiExtensions
+	(*CustomFunctionExtensions)(nil),      // 33: mikros.extensions.CustomFunctionExtensions
+	(*MikrosCustomImport)(nil),            // 34: mikros.extensions.MikrosCustomImport
+	(*MessageInboundExtensions)(nil),      // 35: mikros.extensions.MessageInboundExtensions
+	(*MessageOutboundExtensions)(nil),     // 36: mikros.extensions.MessageOutboundExtensions
+	(*MessageWireInputExtensions)(nil),    // 37: mikros.extensions.MessageWireInputExtensions
+	(*descriptorpb.ServiceOptions)(nil),   // 38: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),    // 39: google.protobuf.MethodOptions
+	(*descriptorpb.EnumOptions)(nil),      // 40: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 41: google.protobuf.EnumValueOptions
+	(*descriptorpb.FieldOptions)(nil),     // 42: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil),     // 43: google.protobuf.OneofOptions
+	(*descriptorpb.MessageOptions)(nil),   // 44: google.protobuf.MessageOptions
 }
 var file_proto_mikros_extensions_proto_depIdxs = []int32{
-	4,  // 0: mikros.extensions.MikrosServiceExtensions.authorization:type_name -> mikros.extensions.HttpAuthorizationExtensions
+	8,  // 0: mikros.extensions.MikrosServiceExtensions.authorization:type_name -> mikros.extensions.HttpAuthorizationExtensions
 	0,  // 1: mikros.extensions.HttpAuthorizationExtensions.mode:type_name -> mikros.extensions.AuthorizationMode
-	6,  // 2: mikros.extensions.MikrosMethodExtensions.http:type_name -> mikros.extensions.HttpMethodExtensions
-	8,  // 3: mikros.extensions.MikrosEnumExtensions.api:type_name -> mikros.extensions.EnumApiExtensions
-	10, // 4: mikros.extensions.MikrosEnumValueExtensions.entry:type_name -> mikros.extensions.EnumEntry
-	12, // 5: mikros.extensions.MikrosFieldExtensions.domain:type_name -> mikros.extensions.FieldDomainOptions
-	14, // 6: mikros.extensions.MikrosFieldExtensions.database:type_name -> mikros.extensions.FieldDatabaseOptions
-	15, // 7: mikros.extensions.MikrosFieldExtensions.inbound:type_name -> mikros.extensions.FieldInboundOptions
-	16, // 8: mikros.extensions.MikrosFieldExtensions.outbound:type_name -> mikros.extensions.FieldOutboundOptions
-	18, // 9: mikros.extensions.MikrosFieldExtensions.validate:type_name -> mikros.extensions.FieldValidateOptions
-	19, // 10: mikros.extensions.MikrosFieldExtensions.testing:type_name -> mikros.extensions.FieldTestingOptions
-	13, // 11: mikros.extensions.FieldDomainOptions.struct_tag:type_name -> mikros.extensions.FieldStructTag
-	17, // 12: mikros.extensions.FieldOutboundOptions.bitflag:type_name -> mikros.extensions.OutboundBitflagField
-	13, // 13: mikros.extensions.FieldOutboundOptions.struct_tag:type_name -> mikros.extensions.FieldStructTag
-	24, // 14: mikros.extensions.FieldOutboundOptions.custom_import:type_name -> mikros.extensions.MikrosCustomImport
-	1,  // 15: mikros.extensions.FieldValidateOptions.rule:type_name -> mikros.extensions.FieldValidatorRule
-	21, // 16: mikros.extensions.MikrosMessageExtensions.domain:type_name -> mikros.extensions.MessageDomainExtensions
-	22, // 17: mikros.extensions.MikrosMessageExtensions.custom_api:type_name -> mikros.extensions.MessageCustomApiExtensions
-	25, // 18: mikros.extensions.MikrosMessageExtensions.inbound:type_name -> mikros.extensions.MessageInboundExtensions
-	26, // 19: mikros.extensions.MikrosMessageExtensions.outbound:type_name -> mikros.extensions.MessageOutboundExtensions
-	27, // 20: mikros.extensions.MikrosMessageExtensions.wire_input:type_name -> mikros.extensions.MessageWireInputExtensions
-	2,  // 21: mikros.extensions.MessageDomainExtensions.naming_mode:type_name -> mikros.extensions.NamingMode
-	23, // 22: mikros.extensions.MessageCustomApiExtensions.function:type_name -> mikros.extensions.CustomFunctionExtensions
-	24, // 23: mikros.extensions.CustomFunctionExtensions.import:type_name -> mikros.extensions.MikrosCustomImport
-	2,  // 24: mikros.extensions.MessageInboundExtensions.naming_mode:type_name -> mikros.extensions.NamingMode
-	2,  // 25: mikros.extensions.MessageOutboundExtensions.naming_mode:type_name -> mikros.extensions.NamingMode
-	28, // 26: mikros.extensions.service_options:extendee -> google.protobuf.ServiceOptions
-	29, // 27: mikros.extensions.method_options:extendee -> google.protobuf.MethodOptions
-	30, // 28: mikros.extensions.enum_options:extendee -> google.protobuf.EnumOptions
-	31, // 29: mikros.extensions.enum_value_options:extendee -> google.protobuf.EnumValueOptions
-	32, // 30: mikros.extensions.field_options:extendee -> google.protobuf.FieldOptions
-	33, // 31: mikros.extensions.message_options:extendee -> google.protobuf.MessageOptions
-	3,  // 32: mikros.extensions.service_options:type_name -> mikros.extensions.MikrosServiceExtensions
-	5,  // 33: mikros.extensions.method_options:type_name -> mikros.extensions.MikrosMethodExtensions
-	7,  // 34: mikros.extensions.enum_options:type_name -> mikros.extensions.MikrosEnumExtensions
-	9,  // 35: mikros.extensions.enum_value_options:type_name -> mikros.extensions.MikrosEnumValueExtensions
-	11, // 36: mikros.extensions.field_options:type_name -> mikros.extensions.MikrosFieldExtensions
-	20, // 37: mikros.extensions.message_options:type_name -> mikros.extensions.MikrosMessageExtensions
-	38, // [38:38] is the sub-list for method output_type
-	38, // [38:38] is the sub-list for method input_type
-	32, // [32:38] is the sub-list for extension type_name
-	26, // [26:32] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	10, // 2: mikros.extensions.MikrosMethodExtensions.http:type_name -> mikros.extensions.HttpMethodExtensions
+	12, // 3: mikros.extensions.MikrosEnumExtensions.api:type_name -> mikros.extensions.EnumApiExtensions
+	14, // 4: mikros.extensions.MikrosEnumValueExtensions.entry:type_name -> mikros.extensions.EnumEntry
+	16, // 5: mikros.extensions.MikrosFieldExtensions.domain:type_name -> mikros.extensions.FieldDomainOptions
+	18, // 6: mikros.extensions.MikrosFieldExtensions.database:type_name -> mikros.extensions.FieldDatabaseOptions
+	20, // 7: mikros.extensions.MikrosFieldExtensions.inbound:type_name -> mikros.extensions.FieldInboundOptions
+	21, // 8: mikros.extensions.MikrosFieldExtensions.outbound:type_name -> mikros.extensions.FieldOutboundOptions
+	23, // 9: mikros.extensions.MikrosFieldExtensions.validate:type_name -> mikros.extensions.FieldValidateOptions
+	24, // 10: mikros.extensions.MikrosFieldExtensions.testing:type_name -> mikros.extensions.FieldTestingOptions
+	17, // 11: mikros.extensions.FieldDomainOptions.struct_tag:type_name -> mikros.extensions.FieldStructTag
+	1,  // 12: mikros.extensions.FieldDatabaseOptions.storage_type:type_name -> mikros.extensions.DatabaseStorageType
+	19, // 13: mikros.extensions.FieldDatabaseOptions.relation:type_name -> mikros.extensions.DatabaseRelation
+	3,  // 14: mikros.extensions.FieldDatabaseOptions.serializer:type_name -> mikros.extensions.DatabaseSerializer
+	2,  // 15: mikros.extensions.DatabaseRelation.kind:type_name -> mikros.extensions.DatabaseRelationKind
+	22, // 16: mikros.extensions.FieldOutboundOptions.bitflag:type_name -> mikros.extensions.OutboundBitflagField
+	17, // 17: mikros.extensions.FieldOutboundOptions.struct_tag:type_name -> mikros.extensions.FieldStructTag
+	34, // 18: mikros.extensions.FieldOutboundOptions.custom_import:type_name -> mikros.extensions.MikrosCustomImport
+	4,  // 19: mikros.extensions.FieldOutboundOptions.bytes_encoding:type_name -> mikros.extensions.BytesEncoding
+	5,  // 20: mikros.extensions.FieldValidateOptions.rule:type_name -> mikros.extensions.FieldValidatorRule
+	23, // 21: mikros.extensions.FieldValidateOptions.keys:type_name -> mikros.extensions.FieldValidateOptions
+	23, // 22: mikros.extensions.FieldValidateOptions.values:type_name -> mikros.extensions.FieldValidateOptions
+	26, // 23: mikros.extensions.MikrosOneofExtensions.validate:type_name -> mikros.extensions.OneofValidateOptions
+	28, // 24: mikros.extensions.MikrosMessageExtensions.domain:type_name -> mikros.extensions.MessageDomainExtensions
+	32, // 25: mikros.extensions.MikrosMessageExtensions.custom_api:type_name -> mikros.extensions.MessageCustomApiExtensions
+	35, // 26: mikros.extensions.MikrosMessageExtensions.inbound:type_name -> mikros.extensions.MessageInboundExtensions
+	36, // 27: mikros.extensions.MikrosMessageExtensions.outbound:type_name -> mikros.extensions.MessageOutboundExtensions
+	37, // 28: mikros.extensions.MikrosMessageExtensions.wire_input:type_name -> mikros.extensions.MessageWireInputExtensions
+	29, // 29: mikros.extensions.MikrosMessageExtensions.database:type_name -> mikros.extensions.MessageDatabaseExtensions
+	6,  // 30: mikros.extensions.MessageDomainExtensions.naming_mode:type_name -> mikros.extensions.NamingMode
+	30, // 31: mikros.extensions.MessageDatabaseExtensions.index:type_name -> mikros.extensions.DatabaseIndex
+	31, // 32: mikros.extensions.DatabaseIndex.field:type_name -> mikros.extensions.DatabaseIndexField
+	33, // 33: mikros.extensions.MessageCustomApiExtensions.function:type_name -> mikros.extensions.CustomFunctionExtensions
+	34, // 34: mikros.extensions.CustomFunctionExtensions.import:type_name -> mikros.extensions.MikrosCustomImport
+	6,  // 35: mikros.extensions.MessageInboundExtensions.naming_mode:type_name -> mikros.extensions.NamingMode
+	6,  // 36: mikros.extensions.MessageOutboundExtensions.naming_mode:type_name -> mikros.extensions.NamingMode
+	38, // 37: mikros.extensions.service_options:extendee -> google.protobuf.ServiceOptions
+	39, // 38: mikros.extensions.method_options:extendee -> google.protobuf.MethodOptions
+	40, // 39: mikros.extensions.enum_options:extendee -> google.protobuf.EnumOptions
+	41, // 40: mikros.extensions.enum_value_options:extendee -> google.protobuf.EnumValueOptions
+	42, // 41: mikros.extensions.field_options:extendee -> google.protobuf.FieldOptions
+	43, // 42: mikros.extensions.oneof_options:extendee -> google.protobuf.OneofOptions
+	44, // 43: mikros.extensions.message_options:extendee -> google.protobuf.MessageOptions
+	7,  // 44: mikros.extensions.service_options:type_name -> mikros.extensions.MikrosServiceExtensions
+	9,  // 45: mikros.extensions.method_options:type_name -> mikros.extensions.MikrosMethodExtensions
+	11, // 46: mikros.extensions.enum_options:type_name -> mikros.extensions.MikrosEnumExtensions
+	13, // 47: mikros.extensions.enum_value_options:type_name -> mikros.extensions.MikrosEnumValueExtensions
+	15, // 48: mikros.extensions.field_options:type_name -> mikros.extensions.MikrosFieldExtensions
+	25, // 49: mikros.extensions.oneof_options:type_name -> mikros.extensions.MikrosOneofExtensions
+	27, // 50: mikros.extensions.message_options:type_name -> mikros.extensions.MikrosMessageExtensions
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	44, // [44:51] is the sub-list for extension type_name
+	37, // [37:44] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_proto_mikros_extensions_proto_init() }
@@ -2292,316 +3012,14 @@ func file_proto_mikros_extensions_proto_init() {
 	if File_proto_mikros_extensions_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_mikros_extensions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosServiceExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpAuthorizationExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosMethodExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpMethodExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosEnumExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumApiExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosEnumValueExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumEntry); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosFieldExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldDomainOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldStructTag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldDatabaseOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldInboundOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldOutboundOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutboundBitflagField); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldValidateOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldTestingOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosMessageExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageDomainExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageCustomApiExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomFunctionExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MikrosCustomImport); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageInboundExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageOutboundExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mikros_extensions_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageWireInputExtensions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_mikros_extensions_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   25,
-			NumExtensions: 6,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_mikros_extensions_proto_rawDesc), len(file_proto_mikros_extensions_proto_rawDesc)),
+			NumEnums:      7,
+			NumMessages:   31,
+			NumExtensions: 7,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_mikros_extensions_proto_goTypes,
@@ -2611,7 +3029,6 @@ func file_proto_mikros_extensions_proto_init() {
 		ExtensionInfos:    file_proto_mikros_extensions_proto_extTypes,
 	}.Build()
 	File_proto_mikros_extensions_proto = out.File
-	file_proto_mikros_extensions_proto_rawDesc = nil
 	file_proto_mikros_extensions_proto_goTypes = nil
 	file_proto_mikros_extensions_proto_depIdxs = nil
 }
@@ -3,6 +3,7 @@ package extensions
 import (
 	"regexp"
 
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
@@ -18,6 +19,28 @@ func LoadGoogleAnnotations(method *descriptor.MethodDescriptorProto) *annotation
 	return nil
 }
 
+// LoadBufValidateFieldRules loads the buf.validate (protovalidate) rules from
+// the field options.
+func LoadBufValidateFieldRules(field *descriptor.FieldDescriptorProto) *validate.FieldRules {
+	if field.Options != nil && proto.HasExtension(field.Options, validate.E_Field) {
+		r := proto.GetExtension(field.Options, validate.E_Field)
+		return r.(*validate.FieldRules)
+	}
+
+	return nil
+}
+
+// LoadBufValidateMessageRules loads the buf.validate (protovalidate) rules
+// from the message options.
+func LoadBufValidateMessageRules(msg *descriptor.DescriptorProto) *validate.MessageRules {
+	if msg.Options != nil && proto.HasExtension(msg.Options, validate.E_Message) {
+		r := proto.GetExtension(msg.Options, validate.E_Message)
+		return r.(*validate.MessageRules)
+	}
+
+	return nil
+}
+
 // GetHTTPEndpoint returns the endpoint and method from the HTTP rule.
 func GetHTTPEndpoint(rule *annotations.HttpRule) (string, string) {
 	var (
@@ -136,6 +159,18 @@ func LoadMessageExtensions(message *descriptor.DescriptorProto) *MikrosMessageEx
 	return nil
 }
 
+// LoadOneofExtensions loads the Mikros extensions from the oneof options.
+func LoadOneofExtensions(oneof *descriptor.OneofDescriptorProto) *MikrosOneofExtensions {
+	if oneof.Options != nil {
+		v := proto.GetExtension(oneof.Options, E_OneofOptions)
+		if val, ok := v.(*MikrosOneofExtensions); ok {
+			return val
+		}
+	}
+
+	return nil
+}
+
 // LoadServiceExtensions loads the Mikros extensions from the service options.
 func LoadServiceExtensions(service *descriptor.ServiceDescriptorProto) *MikrosServiceExtensions {
 	if service.Options != nil {
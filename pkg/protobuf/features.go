@@ -0,0 +1,86 @@
+package protobuf
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// fieldFeatures gathers the protobuf features of a field. They are resolved
+// from the syntax or edition of its file and from the features declared by
+// its file, message and the field itself, so the remaining code does not need
+// to know how the file was written.
+type fieldFeatures struct {
+	// explicitPresence is the 'field_presence' feature. It's enabled for
+	// proto2 optional and required fields, proto3 optional fields and
+	// editions fields with EXPLICIT or LEGACY_REQUIRED presence.
+	explicitPresence bool
+
+	// utf8Validation is the 'utf8_validation' feature of string fields. It
+	// is only enabled when VERIFY is explicitly declared by the field, its
+	// messages or its file, so the defaults of proto3 and editions files
+	// don't change how the generated code behaves.
+	utf8Validation bool
+}
+
+func resolveFieldFeatures(desc protoreflect.FieldDescriptor) *fieldFeatures {
+	return &fieldFeatures{
+		explicitPresence: desc.HasPresence(),
+		utf8Validation:   desc.Kind() == protoreflect.StringKind && explicitUTF8Validation(desc),
+	}
+}
+
+// explicitUTF8Validation tells if the closest 'utf8_validation' feature
+// declared by the field, one of its messages or its file is VERIFY.
+func explicitUTF8Validation(desc protoreflect.FieldDescriptor) bool {
+	for d := protoreflect.Descriptor(desc); d != nil; d = d.Parent() {
+		if features := declaredFeatures(d); features != nil && features.Utf8Validation != nil {
+			return features.GetUtf8Validation() == descriptor.FeatureSet_VERIFY
+		}
+	}
+
+	return false
+}
+
+// declaredFeatures returns the features declared inside the options of a
+// descriptor.
+func declaredFeatures(d protoreflect.Descriptor) *descriptor.FeatureSet {
+	switch options := d.Options().(type) {
+	case *descriptor.FieldOptions:
+		return options.GetFeatures()
+	case *descriptor.MessageOptions:
+		return options.GetFeatures()
+	case *descriptor.FileOptions:
+		return options.GetFeatures()
+	}
+
+	return nil
+}
+
+// isOptionalField tells if a field is handled through a pointer in the Go
+// protobuf API, i.e., if it has explicit presence or if it's a oneof member.
+// Proto3 files keep relying on their optional declarations, while proto2
+// and editions files, whose scalars may have presence without being declared
+// as optional, use the resolved presence.
+func isOptionalField(
+	proto *descriptor.FieldDescriptorProto,
+	desc protoreflect.FieldDescriptor,
+	features *fieldFeatures,
+) bool {
+	if desc.Syntax() == protoreflect.Proto3 {
+		return proto.GetProto3Optional() || proto.OneofIndex != nil
+	}
+
+	// Members of real oneofs are set through their wrappers.
+	if oneof := desc.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return true
+	}
+
+	switch desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+		// Messages are always pointers and bytes rely on nil slices to
+		// track their presence.
+		return false
+	}
+
+	return features.explicitPresence && !desc.IsList()
+}
@@ -19,6 +19,7 @@ const (
 type Field struct {
 	optional   bool
 	array      bool
+	features   *fieldFeatures
 	Name       string
 	JSONName   string
 	GoName     string
@@ -27,12 +28,15 @@ type Field struct {
 	Schema     *protogen.Field                      `validate:"-"`
 	Proto      *descriptor.FieldDescriptorProto     `validate:"-"`
 	moduleName string
+	oneof      *Oneof
 }
 
 func parseField(proto *descriptor.FieldDescriptorProto, schema *protogen.Field, moduleName string) *Field {
+	features := resolveFieldFeatures(schema.Desc)
 	return &Field{
-		optional:   proto.GetProto3Optional(),
+		optional:   isOptionalField(proto, schema.Desc, features),
 		array:      proto.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED,
+		features:   features,
 		Name:       proto.GetName(),
 		JSONName:   strings.ToLower(strcase.SnakeCase(proto.GetJsonName())),
 		GoName:     schema.GoName,
@@ -55,11 +59,30 @@ func (f *Field) String() string {
 		f.IsArray())
 }
 
-// IsOptional indicates if the Field is declared as optional or not.
+// IsOptional indicates if the Field is handled through a pointer, i.e., if it
+// has explicit presence or if it is a oneof member. Messages and bytes are
+// never optional, since their presence is tracked by nil values.
 func (f *Field) IsOptional() bool {
 	return f.optional
 }
 
+// ValidatesUTF8 indicates if the Field is a string whose 'utf8_validation'
+// feature is explicitly declared as VERIFY.
+func (f *Field) ValidatesUTF8() bool {
+	return f.features.utf8Validation
+}
+
+// IsOneof indicates if the Field is a member of a (non-synthetic) oneof.
+func (f *Field) IsOneof() bool {
+	return f.oneof != nil
+}
+
+// Oneof returns the oneof that the Field belongs to or nil if it isn't a
+// oneof member.
+func (f *Field) Oneof() *Oneof {
+	return f.oneof
+}
+
 // IsArray indicates if the Field is declared as repeated or not.
 func (f *Field) IsArray() bool {
 	return f.array && !f.IsMap()
@@ -70,6 +93,11 @@ func (f *Field) IsTimestamp() bool {
 	return f.IsMessageTypeOf(".google.protobuf.Timestamp")
 }
 
+// IsDuration checks if the Field is of 'google.protobuf.Duration' type.
+func (f *Field) IsDuration() bool {
+	return f.IsMessageTypeOf(".google.protobuf.Duration")
+}
+
 // IsProtoStruct checks if the Field is of 'google.protobuf.Struct' type.
 func (f *Field) IsProtoStruct() bool {
 	return f.IsMessageTypeOf(".google.protobuf.Struct")
@@ -85,6 +113,17 @@ func (f *Field) IsProtoAny() bool {
 	return f.IsMessageTypeOf(".google.protobuf.Any")
 }
 
+// IsFieldMask checks if the Field is of 'google.protobuf.FieldMask' type.
+func (f *Field) IsFieldMask() bool {
+	return f.IsMessageTypeOf(".google.protobuf.FieldMask")
+}
+
+// IsWellKnownType checks if the Field is of one of the message types declared
+// inside the google.protobuf package.
+func (f *Field) IsWellKnownType() bool {
+	return f.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE && strings.HasPrefix(f.TypeName, ".google.protobuf.")
+}
+
 // IsMessageTypeOf checks if the Field is of a specific message type.
 func (f *Field) IsMessageTypeOf(typeOf string) bool {
 	return f.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE && f.TypeName == typeOf
@@ -12,6 +12,7 @@ import (
 type Message struct {
 	Name       string
 	Fields     []*Field
+	Oneofs     []*Oneof
 	Schema     *protogen.Message           `validate:"-"`
 	Proto      *descriptor.DescriptorProto `validate:"-"`
 	ModuleName string
@@ -63,6 +64,7 @@ func parseMessage(proto *descriptor.DescriptorProto, schema *protogen.Message, m
 	return &Message{
 		Name:       proto.GetName(),
 		Fields:     fields,
+		Oneofs:     parseOneofs(proto, schema, fields),
 		Schema:     schema,
 		Proto:      proto,
 		ModuleName: moduleName,
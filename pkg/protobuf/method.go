@@ -17,6 +17,7 @@ type Method struct {
 	HTTPMethod   string
 	Endpoint     string
 	Comment      Comment
+	Schema       *protogen.Method `validate:"-"`
 	Proto        *descriptor.MethodDescriptorProto
 }
 
@@ -37,6 +38,7 @@ func parseMethod(proto *descriptor.MethodDescriptorProto, schema *protogen.Metho
 		HTTPMethod:   httpMethod,
 		Endpoint:     endpoint,
 		Comment:      parseMethodComment(schema),
+		Schema:       schema,
 		Proto:        proto,
 	}
 }
@@ -0,0 +1,60 @@
+package protobuf
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Oneof represents a oneof declared inside a message loaded from protobuf.
+type Oneof struct {
+	Name   string
+	GoName string
+	Fields []*Field
+	Schema *protogen.Oneof                  `validate:"-"`
+	Proto  *descriptor.OneofDescriptorProto `validate:"-"`
+}
+
+func (o *Oneof) String() string {
+	fields := make([]string, len(o.Fields))
+	for i, f := range o.Fields {
+		fields[i] = f.Name
+	}
+
+	return fmt.Sprintf(`{name:%v, go_name:%v, fields:[%v]}`,
+		o.Name,
+		o.GoName,
+		strings.Join(fields, ","))
+}
+
+func parseOneofs(proto *descriptor.DescriptorProto, schema *protogen.Message, fields []*Field) []*Oneof {
+	var oneofs []*Oneof
+
+	for i, o := range schema.Oneofs {
+		// Synthetic oneofs are the ones created by protoc for proto3 optional
+		// fields, and they must keep being handled as simple fields.
+		if o.Desc.IsSynthetic() {
+			continue
+		}
+
+		oneof := &Oneof{
+			Name:   string(o.Desc.Name()),
+			GoName: o.GoName,
+			Schema: o,
+			Proto:  proto.GetOneofDecl()[i],
+		}
+
+		for _, f := range fields {
+			if f.Schema.Oneof == o {
+				f.oneof = oneof
+				oneof.Fields = append(oneof.Fields, f)
+			}
+		}
+
+		oneofs = append(oneofs, oneof)
+	}
+
+	return oneofs
+}
@@ -8,18 +8,13 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
-func getPackageProtoFiles(plugin *protogen.Plugin) (map[string]*protogen.File, error) {
+func getPackageProtoFiles(plugin *protogen.Plugin, info *PackageInfo) (map[string]*protogen.File, error) {
 	var (
 		files = make(map[string]*protogen.File)
 	)
 
-	info, err := GetPackageInfo(plugin)
-	if err != nil {
-		return nil, err
-	}
-
 	for name, file := range plugin.FilesByPath {
-		if isProtoFileFromCurrentPackage(file, info.ModuleName) {
+		if isProtoFileFromCurrentPackage(file, info) {
 			files[strings.TrimSuffix(filepath.Base(name), ".proto")] = file
 		}
 	}
@@ -50,14 +45,50 @@ func GetPackageInfo(plugin *protogen.Plugin) (*PackageInfo, error) {
 	// "compiled" by protoc.
 	file := plugin.Files[len(plugin.Files)-1]
 
-	path := strings.ReplaceAll(file.GoImportPath.String(), "\"", "")
+	return newPackageInfo(file), nil
+}
+
+// GetPackages returns the information of every Go package that has .proto
+// files to be generated, in the order they were received from protoc.
+func GetPackages(plugin *protogen.Plugin) ([]*PackageInfo, error) {
+	var (
+		packages []*PackageInfo
+		loaded   = make(map[string]bool)
+	)
+
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+
+		info := newPackageInfo(file)
+		if loaded[info.Path] {
+			continue
+		}
+
+		loaded[info.Path] = true
+		packages = append(packages, info)
+	}
+
+	if len(packages) == 0 {
+		return nil, errors.New("could not find a .proto file to generate")
+	}
+
+	return packages, nil
+}
+
+func newPackageInfo(file *protogen.File) *PackageInfo {
 	return &PackageInfo{
 		PackageName: file.Proto.GetPackage(),
 		ModuleName:  string(file.GoPackageName),
-		Path:        path,
-	}, nil
+		Path:        goImportPath(file),
+	}
+}
+
+func goImportPath(file *protogen.File) string {
+	return strings.ReplaceAll(file.GoImportPath.String(), "\"", "")
 }
 
-func isProtoFileFromCurrentPackage(file *protogen.File, packageName string) bool {
-	return string(file.GoPackageName) == packageName
+func isProtoFileFromCurrentPackage(file *protogen.File, info *PackageInfo) bool {
+	return goImportPath(file) == info.Path
 }
@@ -12,7 +12,7 @@ type Protobuf struct {
 	ModuleName   string
 	PackageName  string
 	FullPath     string
-	Service      *Service
+	Services     []*Service
 	Messages     []*Message
 	Enums        []*Enum
 	PackageFiles map[string]*protogen.File
@@ -22,16 +22,24 @@ type Protobuf struct {
 // ParseOptions represents the options to parse a protobuf file.
 type ParseOptions struct {
 	Plugin *protogen.Plugin
+
+	// Package is the Go package to be parsed. When not set, the package of
+	// the main .proto file is used.
+	Package *PackageInfo
 }
 
 // Parse parses a protobuf file.
 func Parse(options ParseOptions) (*Protobuf, error) {
-	info, err := GetPackageInfo(options.Plugin)
-	if err != nil {
-		return nil, err
+	info := options.Package
+	if info == nil {
+		i, err := GetPackageInfo(options.Plugin)
+		if err != nil {
+			return nil, err
+		}
+		info = i
 	}
 
-	packageProtoFiles, err := getPackageProtoFiles(options.Plugin)
+	packageProtoFiles, err := getPackageProtoFiles(options.Plugin, info)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +51,7 @@ func Parse(options ParseOptions) (*Protobuf, error) {
 
 	files := make(map[string]*protogen.File)
 	for name, f := range options.Plugin.FilesByPath {
-		if string(f.GoPackageName) != info.ModuleName {
+		if !isProtoFileFromCurrentPackage(f, info) {
 			files[name] = f
 		}
 	}
@@ -52,7 +60,7 @@ func Parse(options ParseOptions) (*Protobuf, error) {
 		ModuleName:  info.ModuleName,
 		PackageName: info.PackageName,
 		FullPath:    info.Path,
-		Service: parseService(&parseServiceOptions{
+		Services: parseServices(&parseServicesOptions{
 			Files: packageProtoFiles,
 		}),
 		Messages: parseMessages(&parseMessagesOptions{
@@ -67,6 +75,17 @@ func Parse(options ParseOptions) (*Protobuf, error) {
 	}, nil
 }
 
+// HasHTTPService returns true if any service of the package has HTTP methods.
+func (p *Protobuf) HasHTTPService() bool {
+	for _, s := range p.Services {
+		if s.IsHTTP() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (p *Protobuf) String() string {
 	enums := make([]string, len(p.Enums))
 	for i, e := range p.Enums {
@@ -78,8 +97,13 @@ func (p *Protobuf) String() string {
 		p.FullPath,
 		strings.Join(enums, ","))
 
-	if p.Service != nil {
-		s += ", service:" + p.Service.String()
+	if p.Services != nil {
+		services := make([]string, len(p.Services))
+		for i, svc := range p.Services {
+			services[i] = svc.String()
+		}
+
+		s += ", services:[" + strings.Join(services, ",") + "]"
 	}
 	if p.Messages != nil {
 		messages := make([]string, len(p.Messages))
@@ -2,6 +2,7 @@ package protobuf
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
@@ -15,39 +16,51 @@ type Service struct {
 	Proto   *descriptor.ServiceDescriptorProto
 }
 
-type parseServiceOptions struct {
+type parseServicesOptions struct {
 	Files map[string]*protogen.File
 }
 
-// GetAPIFile returns the first file which has a service definition.
-func (p *parseServiceOptions) GetAPIFile() *protogen.File {
+// GetAPIFiles returns the files which have service definitions, sorted by
+// their names.
+func (p *parseServicesOptions) GetAPIFiles() []*protogen.File {
+	var files []*protogen.File
 	for _, f := range p.Files {
-		// Get the first which has a service definition.
 		if len(f.Services) > 0 {
-			return f
+			files = append(files, f)
 		}
 	}
 
-	return nil
+	// Sort files by name so services are always loaded in the same order.
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Desc.Path() < files[j].Desc.Path()
+	})
+
+	return files
 }
 
-func parseService(options *parseServiceOptions) *Service {
-	api := options.GetAPIFile()
+func parseServices(options *parseServicesOptions) []*Service {
+	var services []*Service
+
+	for _, api := range options.GetAPIFiles() {
+		for i, service := range api.Proto.GetService() {
+			var schema *protogen.Service
+			if i < len(api.Services) {
+				schema = api.Services[i]
+			}
 
-	// Don't do anything if the package does not have an _api.proto file.
-	if api == nil {
-		return nil
+			services = append(services, parseService(service, schema))
+		}
 	}
 
-	var (
-		service = api.Proto.Service[0]
-		methods = make([]*Method, len(service.GetMethod()))
-	)
+	return services
+}
 
+func parseService(service *descriptor.ServiceDescriptorProto, schema *protogen.Service) *Service {
+	methods := make([]*Method, len(service.GetMethod()))
 	for i, method := range service.GetMethod() {
 		var protoMethod *protogen.Method
-		if api.Services[0].Methods != nil {
-			protoMethod = api.Services[0].Methods[i]
+		if schema != nil && i < len(schema.Methods) {
+			protoMethod = schema.Methods[i]
 		}
 
 		methods[i] = parseMethod(method, protoMethod)
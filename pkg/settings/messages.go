@@ -0,0 +1,154 @@
+package settings
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+)
+
+const (
+	defaultMessagesLocale = "en"
+)
+
+// ValidationMessages represents the settings of localized validation error
+// messages.
+type ValidationMessages struct {
+	// DefaultLocale is the locale used when validations are called without
+	// one, and the fallback when a message is missing in the requested one.
+	DefaultLocale string `toml:"default_locale"`
+
+	// Catalogs maps locales to their message catalog files. Relative paths
+	// are resolved from the settings file directory.
+	Catalogs map[string]string `toml:"catalogs"`
+}
+
+// MessageCatalog represents the messages of a single locale.
+type MessageCatalog struct {
+	Locale   string
+	Messages []*Message
+}
+
+// Message represents a localized message of a catalog.
+type Message struct {
+	Key   string
+	Value string
+}
+
+func (s *Settings) loadMessageCatalogs(baseDir string) error {
+	if s.Validations == nil || s.Validations.Messages == nil {
+		return nil
+	}
+
+	s.messageCatalogs = make(map[string]map[string]string)
+	for locale, filename := range s.Validations.Messages.Catalogs {
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(baseDir, filename)
+		}
+
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("could not load '%s' messages catalog: %w", locale, err)
+		}
+
+		var content map[string]interface{}
+		if err := toml.Unmarshal(data, &content); err != nil {
+			return fmt.Errorf("could not parse '%s' messages catalog: %w", locale, err)
+		}
+
+		messages := make(map[string]string)
+		if err := flattenMessages("", content, messages); err != nil {
+			return fmt.Errorf("invalid '%s' messages catalog: %w", locale, err)
+		}
+		s.messageCatalogs[locale] = messages
+	}
+
+	if _, ok := s.messageCatalogs[s.DefaultMessagesLocale()]; !ok {
+		return fmt.Errorf("validation messages catalog for default locale '%s' not set", s.DefaultMessagesLocale())
+	}
+
+	return nil
+}
+
+// flattenMessages allows catalogs to group their keys using TOML tables,
+// which are joined by dots.
+func flattenMessages(prefix string, content map[string]interface{}, messages map[string]string) error {
+	for k, v := range content {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+
+		switch value := v.(type) {
+		case string:
+			messages[key] = value
+		case map[string]interface{}:
+			if err := flattenMessages(key, value, messages); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("message '%s' must be a string", key)
+		}
+	}
+
+	return nil
+}
+
+// ValidationMessagesEnabled checks if localized validation messages were
+// configured.
+func (s *Settings) ValidationMessagesEnabled() bool {
+	return s.messageCatalogs != nil
+}
+
+// DefaultMessagesLocale returns the locale used by default for validation
+// messages.
+func (s *Settings) DefaultMessagesLocale() string {
+	if s.Validations != nil && s.Validations.Messages != nil && s.Validations.Messages.DefaultLocale != "" {
+		return s.Validations.Messages.DefaultLocale
+	}
+
+	return defaultMessagesLocale
+}
+
+// HasValidationMessage checks if a message key is declared in the default
+// locale catalog.
+func (s *Settings) HasValidationMessage(key string) bool {
+	_, ok := s.messageCatalogs[s.DefaultMessagesLocale()][key]
+	return ok
+}
+
+// ValidationMessageCatalogs returns all message catalogs sorted by their
+// locale and keys.
+func (s *Settings) ValidationMessageCatalogs() []*MessageCatalog {
+	locales := make([]string, 0, len(s.messageCatalogs))
+	for locale := range s.messageCatalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	catalogs := make([]*MessageCatalog, len(locales))
+	for i, locale := range locales {
+		keys := make([]string, 0, len(s.messageCatalogs[locale]))
+		for k := range s.messageCatalogs[locale] {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		messages := make([]*Message, len(keys))
+		for j, k := range keys {
+			messages[j] = &Message{
+				Key:   k,
+				Value: s.messageCatalogs[locale][k],
+			}
+		}
+
+		catalogs[i] = &MessageCatalog{
+			Locale:   locale,
+			Messages: messages,
+		}
+	}
+
+	return catalogs
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	"dario.cat/mergo"
@@ -15,6 +17,9 @@ import (
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
 )
 
+// builtinDatabaseKinds are the database kinds supported by the plugin.
+var builtinDatabaseKinds = []string{"mongo", "gorm", "sqlx", "dynamodb"}
+
 // Settings represents the settings loaded from the configuration file.
 type Settings struct {
 	Debug       bool         `toml:"debug"`
@@ -25,6 +30,9 @@ type Settings struct {
 	Validations *Validations `toml:"validations"`
 	Addons      *Addons      `toml:"addons"`
 	Testing     *Testing     `toml:"testing" default:"{}"`
+
+	messageCatalogs map[string]map[string]string
+	databaseKinds   []string
 }
 
 // Suffix represents the suffixes used in the generated code.
@@ -38,7 +46,20 @@ type Suffix struct {
 
 // Database represents the database used in the generated code.
 type Database struct {
-	Kind string `toml:"kind" validate:"oneof=mongo gorm" default:"mongo"`
+	Kind       string      `toml:"kind" default:"mongo"`
+	Dialect    string      `toml:"dialect" validate:"oneof=postgres mysql" default:"postgres"`
+	Migrations *Migrations `toml:"migrations"`
+}
+
+// Migrations represents the SQL migrations generated from the differences
+// between the current schema and a previously generated one.
+type Migrations struct {
+	Version string `toml:"version" validate:"required"`
+
+	// SnapshotPath is the directory with the schema snapshots of a previous
+	// execution, usually the SQL templates output directory. Relative paths
+	// start at the settings file directory.
+	SnapshotPath string `toml:"snapshot_path" validate:"required"`
 }
 
 // HTTP represents the HTTP framework used in the generated code.
@@ -52,8 +73,14 @@ type Templates struct {
 	Test     bool    `toml:"test" default:"false"`
 	TestPath string  `toml:"test_path" default:"test"`
 	APIPath  string  `toml:"api_path" default:"go"`
+	SQL      bool    `toml:"sql" default:"false"`
+	SQLPath  string  `toml:"sql_path" default:"sql"`
 	Common   *Common `toml:"common" default:"{}"`
 	Routes   *Routes `toml:"routes" default:"{}"`
+
+	Repository   bool `toml:"repository" default:"false"`
+	Query        bool `toml:"query" default:"false"`
+	Constructors bool `toml:"constructors" default:"false"`
 }
 
 // Common represents the common operations for all templates used in the
@@ -78,6 +105,11 @@ type Routes struct {
 
 // Validations represents the validations used in the generated code.
 type Validations struct {
+	BufValidate       bool                   `toml:"buf_validate"`
+	StructuredErrors  bool                   `toml:"structured_errors"`
+	Domain            bool                   `toml:"domain"`
+	Context           bool                   `toml:"context"`
+	Messages          *ValidationMessages    `toml:"messages"`
 	RulePackageImport *Import                `toml:"rule_package_import"`
 	Rule              map[string]*CustomCall `toml:"rule"`
 	Custom            map[string]*CustomCall `toml:"custom"`
@@ -152,6 +184,14 @@ func LoadSettings(filename string) (*Settings, error) {
 		return nil, err
 	}
 
+	if err := settings.loadMessageCatalogs(filepath.Dir(filename)); err != nil {
+		return nil, err
+	}
+
+	if m := settings.Database.Migrations; m != nil && m.SnapshotPath != "" && !filepath.IsAbs(m.SnapshotPath) {
+		m.SnapshotPath = filepath.Join(filepath.Dir(filename), m.SnapshotPath)
+	}
+
 	return &settings, nil
 }
 
@@ -167,7 +207,35 @@ func loadDefaultSettings() (*Settings, error) {
 // Validate validates the settings.
 func (s *Settings) Validate() error {
 	validate := validator.New()
-	return validate.Struct(s)
+	if err := validate.Struct(s); err != nil {
+		return err
+	}
+
+	// Database kinds are not validated by tags because addons may register
+	// new ones.
+	if kind := s.Database.Kind; !s.IsSupportedDatabaseKind(kind) {
+		return fmt.Errorf("unsupported database kind '%s'", kind)
+	}
+
+	return nil
+}
+
+// RegisterDatabaseKind adds a database kind, other than the ones supported by
+// the plugin, to the kinds accepted by the settings. It must be called before
+// validating them.
+func (s *Settings) RegisterDatabaseKind(kind string) error {
+	if s.IsSupportedDatabaseKind(kind) {
+		return fmt.Errorf("database kind '%s' is already registered", kind)
+	}
+
+	s.databaseKinds = append(s.databaseKinds, kind)
+	return nil
+}
+
+// IsSupportedDatabaseKind checks if a database kind is supported by the plugin
+// or registered by an addon.
+func (s *Settings) IsSupportedDatabaseKind(kind string) bool {
+	return slices.Contains(builtinDatabaseKinds, kind) || slices.Contains(s.databaseKinds, kind)
 }
 
 // IsSupportedCustomValidationRule checks if a custom validation rule is
@@ -188,6 +256,30 @@ func (s *Settings) IsSupportedCustomValidationRule(ruleName string) error {
 	return nil
 }
 
+// BufValidateEnabled checks if buf.validate (protovalidate) annotations should
+// be translated into validations.
+func (s *Settings) BufValidateEnabled() bool {
+	return s.Validations != nil && s.Validations.BufValidate
+}
+
+// StructuredValidationErrorsEnabled checks if the generated validations
+// should return structured errors instead of the ozzo-validation ones.
+func (s *Settings) StructuredValidationErrorsEnabled() bool {
+	return s.Validations != nil && s.Validations.StructuredErrors
+}
+
+// DomainValidationEnabled checks if the validations should also be generated
+// for domain structures.
+func (s *Settings) DomainValidationEnabled() bool {
+	return s.Validations != nil && s.Validations.Domain
+}
+
+// ContextValidationEnabled checks if the validations should be generated
+// with context-aware APIs.
+func (s *Settings) ContextValidationEnabled() bool {
+	return s.Validations != nil && s.Validations.Context
+}
+
 // GetValidationRule retrieves the validation rule settings for the specified
 // rule.
 func (s *Settings) GetValidationRule(rule extensions.FieldValidatorRule) (*CustomCall, error) {
@@ -254,12 +346,20 @@ type CommonCall struct {
 //
 //revive:disable:line-length-limit
 var (
-	CommonCallToPtr          = CommonCall{CommonAPIConverters, "toPtr", "to_ptr"}
-	CommonCallToValue        = CommonCall{CommonAPIConverters, "toValue", "to_value"}
-	CommonCallProtoToTimePtr = CommonCall{CommonAPIConverters, "protoTimestampToTimePtr", "proto_timestamp_to_go_time_ptr"}
-	CommonCallTimeToProto    = CommonCall{CommonAPIConverters, "timeToProtoTimestamp", "go_time_to_proto_timestamp"}
-	CommonCallMapToStruct    = CommonCall{CommonAPIConverters, "mapToGrpcStruct", "go_map_to_proto_struct"}
-	CommonCallToProtoValue   = CommonCall{CommonAPIConverters, "convertToProtobufValue", "go_interface_to_proto_value"}
+	CommonCallToPtr               = CommonCall{CommonAPIConverters, "toPtr", "to_ptr"}
+	CommonCallToValue             = CommonCall{CommonAPIConverters, "toValue", "to_value"}
+	CommonCallProtoToTimePtr      = CommonCall{CommonAPIConverters, "protoTimestampToTimePtr", "proto_timestamp_to_go_time_ptr"}
+	CommonCallTimeToProto         = CommonCall{CommonAPIConverters, "timeToProtoTimestamp", "go_time_to_proto_timestamp"}
+	CommonCallMapToStruct         = CommonCall{CommonAPIConverters, "mapToGrpcStruct", "go_map_to_proto_struct"}
+	CommonCallToProtoValue        = CommonCall{CommonAPIConverters, "convertToProtobufValue", "go_interface_to_proto_value"}
+	CommonCallProtoToDurationPtr  = CommonCall{CommonAPIConverters, "protoDurationToDurationPtr", "proto_duration_to_go_duration_ptr"}
+	CommonCallDurationToProto     = CommonCall{CommonAPIConverters, "durationToProtoDuration", "go_duration_to_proto_duration"}
+	CommonCallPathsToFieldMask    = CommonCall{CommonAPIConverters, "pathsToProtoFieldMask", "go_paths_to_proto_field_mask"}
+	CommonCallProtoWrapperToPtr   = CommonCall{CommonAPIConverters, "protoWrapperToPtr", "proto_wrapper_to_go_ptr"}
+	CommonCallPtrToProtoWrapper   = CommonCall{CommonAPIConverters, "ptrToProtoWrapper", "go_ptr_to_proto_wrapper"}
+	CommonCallBytesToProtoWrapper = CommonCall{CommonAPIConverters, "bytesToProtoBytesValue", "go_bytes_to_proto_bytes_value"}
+	CommonCallProtoAnyToMessage   = CommonCall{CommonAPIConverters, "protoAnyToMessage", "proto_any_to_go_message"}
+	CommonCallMessageToProtoAny   = CommonCall{CommonAPIConverters, "messageToProtoAny", "go_message_to_proto_any"}
 )
 
 //revive:enable:line-length-limit
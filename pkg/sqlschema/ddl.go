@@ -0,0 +1,104 @@
+package sqlschema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CreateStatements returns the statements that create all tables of a schema,
+// with their indexes.
+func CreateStatements(d Dialect, s *Schema) []string {
+	var statements []string
+	for _, t := range s.Tables {
+		statements = append(statements, createTable(d, t)...)
+	}
+
+	return statements
+}
+
+// Render joins statements into the content of an SQL file.
+func Render(statements []string) string {
+	var b strings.Builder
+	for i, s := range statements {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString(s)
+		if !strings.HasPrefix(s, "--") {
+			b.WriteString(";")
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func createTable(d Dialect, t *Table) []string {
+	lines := make([]string, 0, len(t.Columns)+1)
+	for _, c := range t.Columns {
+		lines = append(lines, d.ColumnDefinition(c))
+	}
+	if pk := t.PrimaryKey(); len(pk) > 0 {
+		lines = append(lines, fmt.Sprintf("PRIMARY KEY (%s)", quoteAll(d, pk)))
+	}
+
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n    %s\n)", d.Quote(t.Name), strings.Join(lines, ",\n    ")),
+	}
+	for _, i := range t.Indexes {
+		statements = append(statements, createIndex(d, t.Name, i))
+	}
+
+	return statements
+}
+
+func dropTable(d Dialect, t *Table) string {
+	return "DROP TABLE " + d.Quote(t.Name)
+}
+
+func createIndex(d Dialect, table string, i *Index) string {
+	unique := ""
+	if i.Unique {
+		unique = "UNIQUE "
+	}
+
+	columns := make([]string, len(i.Columns))
+	for n, c := range i.Columns {
+		columns[n] = d.Quote(c)
+		if slices.Contains(i.Descending, c) {
+			columns[n] += " DESC"
+		}
+	}
+
+	statement := fmt.Sprintf(
+		"CREATE %sINDEX %s ON %s (%s)",
+		unique,
+		d.Quote(i.Name),
+		d.Quote(table),
+		strings.Join(columns, ", "),
+	)
+	if i.Where != "" {
+		statement += " WHERE " + i.Where
+	}
+
+	return statement
+}
+
+func addColumn(d Dialect, table string, c *Column) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", d.Quote(table), d.ColumnDefinition(c))
+}
+
+func dropColumn(d Dialect, table string, c *Column) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", d.Quote(table), d.Quote(c.Name))
+}
+
+func quoteAll(d Dialect, names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = d.Quote(n)
+	}
+
+	return strings.Join(quoted, ", ")
+}
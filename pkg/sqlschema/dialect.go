@@ -0,0 +1,269 @@
+package sqlschema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect is the behavior that an SQL dialect must implement to declare
+// schemas and their changes.
+type Dialect interface {
+	// Name returns the dialect name.
+	Name() string
+
+	// Quote quotes an identifier.
+	Quote(name string) string
+
+	// ColumnDefinition returns the column declaration used inside CREATE
+	// TABLE and ADD COLUMN statements.
+	ColumnDefinition(c *Column) string
+
+	// AlterColumn returns the statements that change a column definition.
+	AlterColumn(table string, from, to *Column) []string
+
+	// DropIndex returns the statement that removes an index from a table.
+	DropIndex(table string, index *Index) string
+}
+
+// Supported dialects names.
+const (
+	DialectPostgres = "postgres"
+	DialectMySQL    = "mysql"
+)
+
+// NewDialect returns the dialect by its name.
+func NewDialect(name string) (Dialect, error) {
+	switch name {
+	case DialectPostgres:
+		return &postgres{}, nil
+	case DialectMySQL:
+		return &mysql{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported SQL dialect '%s'", name)
+	}
+}
+
+type postgres struct{}
+
+func (p *postgres) Name() string {
+	return DialectPostgres
+}
+
+func (p *postgres) Quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (p *postgres) columnType(c *Column) string {
+	if c.Declared != "" {
+		return c.Declared
+	}
+	if c.AutoIncrement {
+		if c.Type == TypeInt32 {
+			return "SERIAL"
+		}
+
+		return "BIGSERIAL"
+	}
+
+	return p.baseType(c)
+}
+
+func (p *postgres) baseType(c *Column) string {
+	if c.Declared != "" {
+		return c.Declared
+	}
+
+	switch c.Type {
+	case TypeBool:
+		return "BOOLEAN"
+	case TypeInt32:
+		return "INTEGER"
+	case TypeUint32, TypeInt64:
+		return "BIGINT"
+	case TypeUint64:
+		return "NUMERIC(20)"
+	case TypeFloat:
+		return "REAL"
+	case TypeDouble:
+		return "DOUBLE PRECISION"
+	case TypeBytes:
+		return "BYTEA"
+	case TypeTimestamp:
+		return "TIMESTAMPTZ"
+	case TypeJSON:
+		return "JSON"
+	case TypeJSONB:
+		return "JSONB"
+	case TypeString:
+		if c.Size > 0 {
+			return fmt.Sprintf("VARCHAR(%d)", c.Size)
+		}
+
+		return "TEXT"
+	default:
+		return "TEXT"
+	}
+}
+
+func (p *postgres) ColumnDefinition(c *Column) string {
+	definition := p.Quote(c.Name) + " " + p.columnType(c)
+	if c.Default != "" {
+		definition += " DEFAULT " + c.Default
+	}
+	if c.NotNull {
+		definition += " NOT NULL"
+	}
+
+	return definition
+}
+
+func (p *postgres) AlterColumn(table string, from, to *Column) []string {
+	var statements []string
+
+	// Serial columns keep their sequences, only their integer type changes.
+	if fromType, toType := p.baseType(from), p.baseType(to); fromType != toType {
+		statements = append(statements, fmt.Sprintf(
+			"ALTER TABLE %s ALTER COLUMN %s TYPE %s",
+			p.Quote(table),
+			p.Quote(to.Name),
+			toType,
+		))
+	}
+
+	if from.Default != to.Default {
+		action := "DROP DEFAULT"
+		if to.Default != "" {
+			action = "SET DEFAULT " + to.Default
+		}
+
+		statements = append(statements, fmt.Sprintf(
+			"ALTER TABLE %s ALTER COLUMN %s %s",
+			p.Quote(table),
+			p.Quote(to.Name),
+			action,
+		))
+	}
+
+	if from.NotNull != to.NotNull {
+		action := "DROP NOT NULL"
+		if to.NotNull {
+			action = "SET NOT NULL"
+		}
+
+		statements = append(statements, fmt.Sprintf(
+			"ALTER TABLE %s ALTER COLUMN %s %s",
+			p.Quote(table),
+			p.Quote(to.Name),
+			action,
+		))
+	}
+
+	return statements
+}
+
+func (p *postgres) DropIndex(_ string, index *Index) string {
+	return "DROP INDEX " + p.Quote(index.Name)
+}
+
+const (
+	// mysqlKeyStringSize is the largest utf8mb4 string that fits into the
+	// keys of older MySQL versions.
+	mysqlKeyStringSize = 191
+
+	// mysqlVarcharLimit is the smallest string size that VARCHAR columns
+	// can't hold.
+	mysqlVarcharLimit = 65536
+
+	// mysqlMediumTextSize is the largest string size held by MEDIUMTEXT
+	// columns.
+	mysqlMediumTextSize = 1 << 24
+)
+
+type mysql struct{}
+
+func (m *mysql) Name() string {
+	return DialectMySQL
+}
+
+func (m *mysql) Quote(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (m *mysql) columnType(c *Column) string {
+	if c.Declared != "" {
+		return c.Declared
+	}
+
+	switch c.Type {
+	case TypeBool:
+		return "BOOLEAN"
+	case TypeInt32:
+		return "INT"
+	case TypeUint32:
+		return "INT UNSIGNED"
+	case TypeInt64:
+		return "BIGINT"
+	case TypeUint64:
+		return "BIGINT UNSIGNED"
+	case TypeFloat:
+		return "FLOAT"
+	case TypeDouble:
+		return "DOUBLE"
+	case TypeBytes:
+		return "BLOB"
+	case TypeTimestamp:
+		return "DATETIME(3)"
+	case TypeJSON, TypeJSONB:
+		return "JSON"
+	default:
+		return m.stringType(c)
+	}
+}
+
+// stringType returns the type of string columns like gorm AutoMigrate does.
+// Strings without a size are only bounded when MySQL requires it, i.e., when
+// they're used by keys or have a default value.
+func (m *mysql) stringType(c *Column) string {
+	size := c.Size
+	if size == 0 && (c.PrimaryKey || c.Indexed || c.Default != "") {
+		size = mysqlKeyStringSize
+	}
+
+	switch {
+	case size <= 0 || size > mysqlMediumTextSize:
+		return "LONGTEXT"
+	case size >= mysqlVarcharLimit:
+		return "MEDIUMTEXT"
+	default:
+		return fmt.Sprintf("VARCHAR(%d)", size)
+	}
+}
+
+func (m *mysql) ColumnDefinition(c *Column) string {
+	definition := m.Quote(c.Name) + " " + m.columnType(c)
+	if c.Default != "" {
+		definition += " DEFAULT " + c.Default
+	}
+	if c.NotNull {
+		definition += " NOT NULL"
+	}
+	if c.AutoIncrement {
+		definition += " AUTO_INCREMENT"
+	}
+
+	return definition
+}
+
+func (m *mysql) AlterColumn(table string, from, to *Column) []string {
+	if m.ColumnDefinition(from) == m.ColumnDefinition(to) {
+		return nil
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s", m.Quote(table), m.ColumnDefinition(to)),
+	}
+}
+
+func (m *mysql) DropIndex(table string, index *Index) string {
+	return fmt.Sprintf("DROP INDEX %s ON %s", m.Quote(index.Name), m.Quote(table))
+}
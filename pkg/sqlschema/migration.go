@@ -0,0 +1,117 @@
+package sqlschema
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
+)
+
+// Migration holds the statements that migrate a schema into another one and
+// the statements that revert them.
+type Migration struct {
+	Up   []string
+	Down []string
+}
+
+// NewMigration returns the migration from a previous schema into the current
+// one. Primary keys are not changed automatically, since the data may not be
+// valid for the new key, so tables whose primary key changed are reported as
+// errors.
+func NewMigration(d Dialect, previous, current *Schema) (*Migration, error) {
+	var errs diagnostic.List
+	for _, t := range current.Tables {
+		p, ok := previous.Table(t.Name)
+		if ok && !slices.Equal(p.PrimaryKey(), t.PrimaryKey()) {
+			errs.Add(fmt.Errorf(
+				"primary key of table '%s' changed from %v to %v and must be migrated manually",
+				t.Name,
+				p.PrimaryKey(),
+				t.PrimaryKey(),
+			))
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	return &Migration{
+		Up:   diff(d, previous, current),
+		Down: diff(d, current, previous),
+	}, nil
+}
+
+// IsEmpty returns true if both schemas are the same.
+func (m *Migration) IsEmpty() bool {
+	return len(m.Up) == 0 && len(m.Down) == 0
+}
+
+// diff returns the statements that change the schema from into the schema to.
+func diff(d Dialect, from, to *Schema) []string {
+	var (
+		dropIndexes   []string
+		createTables  []string
+		alterColumns  []string
+		createIndexes []string
+		dropTables    []string
+	)
+
+	for _, t := range to.Tables {
+		previous, ok := from.Table(t.Name)
+		if !ok {
+			createTables = append(createTables, createTable(d, t)...)
+			continue
+		}
+
+		for _, i := range previous.Indexes {
+			if current, ok := t.Index(i.Name); !ok || !sameIndex(i, current) {
+				dropIndexes = append(dropIndexes, d.DropIndex(t.Name, i))
+			}
+		}
+
+		alterColumns = append(alterColumns, diffColumns(d, previous, t)...)
+
+		for _, i := range t.Indexes {
+			if p, ok := previous.Index(i.Name); !ok || !sameIndex(p, i) {
+				createIndexes = append(createIndexes, createIndex(d, t.Name, i))
+			}
+		}
+	}
+
+	for _, t := range from.Tables {
+		if _, ok := to.Table(t.Name); !ok {
+			dropTables = append(dropTables, dropTable(d, t))
+		}
+	}
+
+	return slices.Concat(dropIndexes, createTables, alterColumns, createIndexes, dropTables)
+}
+
+func diffColumns(d Dialect, from, to *Table) []string {
+	var statements []string
+
+	for _, c := range to.Columns {
+		previous, ok := from.Column(c.Name)
+		if !ok {
+			statements = append(statements, addColumn(d, to.Name, c))
+			continue
+		}
+
+		statements = append(statements, d.AlterColumn(to.Name, previous, c)...)
+	}
+
+	for _, c := range from.Columns {
+		if _, ok := to.Column(c.Name); !ok {
+			statements = append(statements, dropColumn(d, to.Name, c))
+		}
+	}
+
+	return statements
+}
+
+func sameIndex(a, b *Index) bool {
+	return a.Unique == b.Unique &&
+		a.Where == b.Where &&
+		slices.Equal(a.Columns, b.Columns) &&
+		slices.Equal(a.Descending, b.Descending)
+}
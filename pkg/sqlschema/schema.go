@@ -0,0 +1,152 @@
+package sqlschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Type represents the type of column, independent of the SQL dialect used to
+// declare it.
+type Type string
+
+// Supported column types.
+const (
+	TypeBool      Type = "bool"
+	TypeInt32     Type = "int32"
+	TypeUint32    Type = "uint32"
+	TypeInt64     Type = "int64"
+	TypeUint64    Type = "uint64"
+	TypeFloat     Type = "float"
+	TypeDouble    Type = "double"
+	TypeString    Type = "string"
+	TypeBytes     Type = "bytes"
+	TypeTimestamp Type = "timestamp"
+	TypeJSON      Type = "json"
+	TypeJSONB     Type = "jsonb"
+)
+
+// IsInteger returns true if the type holds integer values.
+func (t Type) IsInteger() bool {
+	switch t {
+	case TypeInt32, TypeUint32, TypeInt64, TypeUint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// Schema represents the SQL schema of the domain messages of a package. It is
+// also the snapshot saved by the plugin, so later executions can compute
+// migrations from it.
+type Schema struct {
+	Dialect string   `json:"dialect"`
+	Tables  []*Table `json:"tables"`
+}
+
+// Table represents a table created for a domain message.
+type Table struct {
+	Name    string    `json:"name"`
+	Columns []*Column `json:"columns"`
+	Indexes []*Index  `json:"indexes,omitempty"`
+}
+
+// Column represents a column of a table.
+type Column struct {
+	Name          string `json:"name"`
+	Type          Type   `json:"type"`
+	NotNull       bool   `json:"not_null,omitempty"`
+	PrimaryKey    bool   `json:"primary_key,omitempty"`
+	AutoIncrement bool   `json:"auto_increment,omitempty"`
+	Size          int    `json:"size,omitempty"`
+	Default       string `json:"default,omitempty"`
+
+	// Indexed is set for columns used by indexes of the table.
+	Indexed bool `json:"indexed,omitempty"`
+
+	// Declared holds the type declared by the field, which is used as is
+	// instead of the dialect type.
+	Declared string `json:"declared,omitempty"`
+}
+
+// Index represents an index, or a unique constraint, of a table.
+type Index struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique,omitempty"`
+	Where   string   `json:"where,omitempty"`
+
+	// Descending holds the columns sorted in descending order.
+	Descending []string `json:"descending,omitempty"`
+}
+
+// LoadSnapshot loads a schema previously saved by the plugin. A snapshot that
+// does not exist yet is an empty schema.
+func LoadSnapshot(filename string) (*Schema, error) {
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Schema{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("could not parse schema snapshot '%s': %w", filename, err)
+	}
+
+	return &schema, nil
+}
+
+// Snapshot returns the JSON content that represents the schema.
+func (s *Schema) Snapshot() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// Table returns a table of the schema by its name.
+func (s *Schema) Table(name string) (*Table, bool) {
+	for _, t := range s.Tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// Column returns a column of the table by its name.
+func (t *Table) Column(name string) (*Column, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+
+	return nil, false
+}
+
+// Index returns an index of the table by its name.
+func (t *Table) Index(name string) (*Index, bool) {
+	for _, i := range t.Indexes {
+		if i.Name == name {
+			return i, true
+		}
+	}
+
+	return nil, false
+}
+
+// PrimaryKey returns the name of the columns that compose the table primary
+// key.
+func (t *Table) PrimaryKey() []string {
+	var columns []string
+	for _, c := range t.Columns {
+		if c.PrimaryKey {
+			columns = append(columns, c.Name)
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,124 @@
+package context
+
+import (
+	"go/token"
+	"slices"
+
+	"github.com/stoewer/go-strcase"
+)
+
+// Constructor represents the functions that create a domain message. They
+// receive the required fields as arguments, while the other fields are set
+// by options.
+type Constructor struct {
+	Name       string
+	OptionName string
+	Message    *Message
+	Arguments  []*ConstructorField
+	Options    []*ConstructorField
+}
+
+// ConstructorField represents a field, or a oneof, set by a constructor.
+type ConstructorField struct {
+	Name         string
+	ArgumentName string
+	OptionName   string
+	Type         string
+
+	// Default holds the Go literal of the option value used when the
+	// domain message is created.
+	Default string
+
+	// address is set when the field is a pointer to a value received by
+	// the constructor, so optional scalars don't need to be addressed by
+	// callers.
+	address bool
+}
+
+// Constructors returns the constructors of the domain messages.
+func (c *Context) Constructors() []*Constructor {
+	if !c.settings.Templates.Constructors {
+		return nil
+	}
+
+	var constructors []*Constructor
+	for _, m := range c.DomainMessages() {
+		var (
+			reserved    = []string{m.GetReceiverName(), "opt", "opts"}
+			constructor = &Constructor{
+				Name:       "New" + m.DomainName,
+				OptionName: m.DomainName + "Option",
+				Message:    m,
+			}
+		)
+
+		for _, f := range m.GetFields(domainTemplateName) {
+			field := &ConstructorField{
+				Name:         f.DomainName,
+				ArgumentName: constructorArgumentName(f.DomainName, reserved),
+				OptionName:   m.DomainName + "With" + f.DomainName,
+				Type:         f.DomainType(),
+				Default:      f.DefaultValue(),
+			}
+			if isAddressableField(f) {
+				field.Type = f.Mapping.Types().Domain(false)
+				field.address = true
+			}
+
+			constructor.add(field, f.IsRequired())
+		}
+
+		for _, o := range m.Oneofs {
+			constructor.add(&ConstructorField{
+				Name:         o.DomainName,
+				ArgumentName: constructorArgumentName(o.DomainName, reserved),
+				OptionName:   m.DomainName + "With" + o.DomainName,
+				Type:         "*" + o.DomainType,
+			}, o.IsRequired())
+		}
+
+		constructors = append(constructors, constructor)
+	}
+
+	return constructors
+}
+
+func (c *Constructor) add(field *ConstructorField, required bool) {
+	if required {
+		c.Arguments = append(c.Arguments, field)
+		return
+	}
+
+	c.Options = append(c.Options, field)
+}
+
+// isAddressableField checks if the field is a pointer to a value that the
+// constructors can address themselves.
+func isAddressableField(f *Field) bool {
+	if !f.IsPointer() {
+		return false
+	}
+
+	return !f.IsMessage ||
+		f.ProtoField.IsTimestamp() ||
+		f.ProtoField.IsDuration() ||
+		f.ProtoField.IsProtobufWrapper()
+}
+
+func constructorArgumentName(name string, reserved []string) string {
+	name = strcase.LowerCamelCase(name)
+	if token.IsKeyword(name) || slices.Contains(reserved, name) {
+		return name + "Value"
+	}
+
+	return name
+}
+
+// Value returns the value assigned to the field from its argument.
+func (f *ConstructorField) Value() string {
+	if f.address {
+		return "&" + f.ArgumentName
+	}
+
+	return f.ArgumentName
+}
@@ -1,10 +1,15 @@
 package context
 
 import (
+	"strings"
+	"unicode"
+
 	"github.com/go-playground/validator/v10"
+	"github.com/stoewer/go-strcase"
 	"google.golang.org/protobuf/compiler/protogen"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/internal/addon"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/internal/validation"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
@@ -17,9 +22,11 @@ type Context struct {
 	PluginName string
 	ModuleName string
 	Enums      []*Enum
+	Services   []*Service
 	Methods    []*Method
 	Package    *protobuf.Protobuf
 
+	service  *Service
 	messages []*Message
 	imports  map[spec.Name][]*templateImport
 	addons   map[string]*addon.Addon
@@ -32,6 +39,10 @@ type BuildContextOptions struct {
 	Settings   *settings.Settings `validate:"required"`
 	Plugin     *protogen.Plugin   `validate:"required"`
 	Addons     []*addon.Addon
+
+	// Package is the Go package that the context is built for. When not
+	// set, the package of the main .proto file is used.
+	Package *protobuf.PackageInfo
 }
 
 // BuildContext builds the context from the protobuf file(s).
@@ -43,12 +54,21 @@ func BuildContext(opt BuildContextOptions) (*Context, error) {
 
 	// Handle the protobuf file(s)
 	pkg, err := protobuf.Parse(protobuf.ParseOptions{
-		Plugin: opt.Plugin,
+		Plugin:  opt.Plugin,
+		Package: opt.Package,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Check the validation annotations before building anything from them
+	if err := validation.CheckAnnotations(&validation.CheckOptions{
+		Messages: pkg.Messages,
+		Settings: opt.Settings,
+	}); err != nil {
+		return nil, err
+	}
+
 	// And build the templates context
 	messages, err := loadMessages(pkg, loadMessagesOptions{
 		Settings: opt.Settings,
@@ -57,15 +77,21 @@ func BuildContext(opt BuildContextOptions) (*Context, error) {
 		return nil, err
 	}
 
-	methods, err := loadMethods(pkg, messages, opt.Settings)
+	services, err := loadServices(pkg, messages, opt.Settings)
 	if err != nil {
 		return nil, err
 	}
 
+	var methods []*Method
+	for _, s := range services {
+		methods = append(methods, s.Methods...)
+	}
+
 	ctx := &Context{
 		PluginName: opt.PluginName,
 		ModuleName: pkg.ModuleName,
 		Enums:      loadEnums(pkg),
+		Services:   services,
 		Methods:    methods,
 		messages:   messages,
 		Package:    pkg,
@@ -128,7 +154,19 @@ func (c *Context) HasAddonImportFor(addonName, tplName string) bool {
 
 // IsHTTPService returns true if the current package is an HTTP service.
 func (c *Context) IsHTTPService() bool {
-	return c.Package.Service != nil && c.Package.Service.IsHTTP()
+	return c.Package.HasHTTPService()
+}
+
+// HTTPServices returns the services of the package that have HTTP methods.
+func (c *Context) HTTPServices() []*Service {
+	var services []*Service
+	for _, s := range c.Services {
+		if s.IsHTTP() {
+			services = append(services, s)
+		}
+	}
+
+	return services
 }
 
 // DomainMessages returns the messages that should be exported as domain.
@@ -224,6 +262,15 @@ func (c *Context) GetTemplateValidator(name spec.Name, _ interface{}) (spec.Exec
 		spec.NewName("api", "validation"): func() bool {
 			return c.HasValidatableMessage()
 		},
+		spec.NewName("api", "repository"): func() bool {
+			return len(c.Repositories()) > 0
+		},
+		spec.NewName("api", "query"): func() bool {
+			return len(c.Queries()) > 0
+		},
+		spec.NewName("api", "constructor"): func() bool {
+			return len(c.Constructors()) > 0
+		},
 		spec.NewName("testing", "testing"): func() bool {
 			return len(c.DomainMessages()) > 0 && c.settings.Templates.Test
 		},
@@ -242,14 +289,86 @@ func (c *Context) Extension() string {
 }
 
 // ServiceName returns the name of the service associated with the context.
+// When the context is not scoped to a service, the first service of the
+// package is used.
 func (c *Context) ServiceName() string {
-	if c.Package.Service != nil {
-		return c.Package.Service.Name
+	if c.service != nil {
+		return c.service.Name
+	}
+	if len(c.Services) > 0 {
+		return c.Services[0].Name
 	}
 
 	return c.ModuleName
 }
 
+// ServiceIdentifier returns a Go identifier for a declaration that belongs to
+// the service of the context. When the package has more than one HTTP service,
+// the service name is added to it, so declarations of different services don't
+// collide.
+func (c *Context) ServiceIdentifier(name string) string {
+	if c.service == nil || len(c.HTTPServices()) <= 1 || name == "" {
+		return name
+	}
+
+	if unicode.IsLower(rune(name[0])) {
+		return strcase.LowerCamelCase(c.service.Name) + strcase.UpperCamelCase(name)
+	}
+
+	return c.service.Name + name
+}
+
+// IsFirstService returns true if the context is not scoped to a service or if
+// it is scoped to the first HTTP service of the package. Declarations shared
+// by all services are only generated for it.
+func (c *Context) IsFirstService() bool {
+	services := c.HTTPServices()
+	return c.service == nil || len(services) == 0 || services[0] == c.service
+}
+
+// GetTemplateScopes returns the scopes that a template must be executed with.
+// HTTP templates are executed once for each HTTP service, each one generating
+// its own file when the package has more than one.
+func (c *Context) GetTemplateScopes(name spec.Name) ([]*spec.Scope, bool) {
+	scoped := map[spec.Name]bool{
+		spec.NewName("api", "http_server"):     true,
+		spec.NewName("api", "routes"):          true,
+		spec.NewName("testing", "http_server"): true,
+	}
+	if !scoped[name] {
+		return nil, false
+	}
+
+	var (
+		services = c.HTTPServices()
+		scopes   = make([]*spec.Scope, len(services))
+	)
+
+	for i, s := range services {
+		scopeName := ""
+		if len(services) > 1 {
+			scopeName = s.Name
+		}
+
+		scopes[i] = &spec.Scope{
+			Name:    scopeName,
+			Context: c.scopedTo(s),
+		}
+	}
+
+	return scopes, true
+}
+
+// scopedTo returns a copy of the context restricted to a single service.
+func (c *Context) scopedTo(service *Service) *Context {
+	ctx := *c
+	ctx.service = service
+	ctx.Methods = service.Methods
+	ctx.imports = loadImports(&ctx, c.settings)
+
+	return &ctx
+}
+
 // HasRequiredBody returns true if the service has any method with a required
 // body.
 func (c *Context) HasRequiredBody() bool {
@@ -278,10 +397,24 @@ func (c *Context) OutboundHasBitflagField() bool {
 	return false
 }
 
+// HasDatabaseStorageType returns true if any domain message has a field held
+// with the given database storage type.
+func (c *Context) HasDatabaseStorageType(storageType string) bool {
+	for _, m := range c.DomainMessages() {
+		for _, f := range m.Fields {
+			if f.DatabaseStorageType() == storageType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // HasValidatableMessage returns true if the service has any message with a
 // validatable field.
 func (c *Context) HasValidatableMessage() bool {
-	return len(c.ValidatableMessages()) > 0
+	return len(c.ValidatableMessages()) > 0 || len(c.DomainValidatableMessages()) > 0
 }
 
 // ValidatableMessages returns the messages that have a validatable field.
@@ -296,6 +429,111 @@ func (c *Context) ValidatableMessages() []*Message {
 	return messages
 }
 
+// DomainValidatableMessages returns the domain messages that have a field
+// validatable inside their domain structure.
+func (c *Context) DomainValidatableMessages() []*Message {
+	if !c.settings.DomainValidationEnabled() {
+		return nil
+	}
+
+	var messages []*Message
+	for _, m := range c.DomainMessages() {
+		if m.HasDomainValidatableField() {
+			messages = append(messages, m)
+		}
+	}
+
+	return messages
+}
+
+// HasTimestampValidation returns true if any validation uses rules of
+// google.protobuf.Timestamp fields.
+func (c *Context) HasTimestampValidation() bool {
+	return c.validationCallsContain("timestampRule(")
+}
+
+// HasDurationValidation returns true if any validation uses rules of
+// google.protobuf.Duration fields.
+func (c *Context) HasDurationValidation() bool {
+	return c.validationCallsContain("durationRule(")
+}
+
+// HasDomainTimestampValidation returns true if any domain validation uses
+// rules of google.protobuf.Timestamp fields.
+func (c *Context) HasDomainTimestampValidation() bool {
+	return c.validationCallsContain("domainTimestampRule(")
+}
+
+// HasDomainTimestampFieldValidation returns true if any domain validation
+// compares timestamps against other fields.
+func (c *Context) HasDomainTimestampFieldValidation() bool {
+	return c.validationCallsContain("domainTimestampValue(")
+}
+
+// HasDomainDurationValidation returns true if any domain validation uses
+// rules of google.protobuf.Duration fields.
+func (c *Context) HasDomainDurationValidation() bool {
+	return c.validationCallsContain("domainDurationRule(")
+}
+
+// HasStorageTypeValidation returns true if any validation checks the format
+// of fields with a database storage type.
+func (c *Context) HasStorageTypeValidation() bool {
+	return c.HasObjectIDValidation() || c.HasDecimal128Validation() || c.HasDateValidation()
+}
+
+// HasObjectIDValidation returns true if any validation checks fields stored
+// as ObjectIDs.
+func (c *Context) HasObjectIDValidation() bool {
+	return c.validationCallsContain("objectIDRule)")
+}
+
+// HasDecimal128Validation returns true if any validation checks fields
+// stored as Decimal128 values.
+func (c *Context) HasDecimal128Validation() bool {
+	return c.validationCallsContain("decimal128Rule)")
+}
+
+// HasDateValidation returns true if any validation checks string fields
+// stored as dates.
+func (c *Context) HasDateValidation() bool {
+	return c.validationCallsContain("dateRule)")
+}
+
+// HasTestingTimestampRelation returns true if any testing value is derived
+// from a timestamp field that it is related to.
+func (c *Context) HasTestingTimestampRelation() bool {
+	for _, m := range c.DomainMessages() {
+		for _, f := range m.Fields {
+			if !f.IsOneofMember() && strings.HasPrefix(f.TestingValueCall(), "timeBetween(") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (c *Context) validationCallsContain(s string) bool {
+	for _, m := range c.ValidatableMessages() {
+		for _, f := range m.Fields {
+			if strings.Contains(f.ValidationCall(), s) {
+				return true
+			}
+		}
+	}
+
+	for _, m := range c.DomainValidatableMessages() {
+		for _, f := range m.Fields {
+			if strings.Contains(f.DomainValidationCall(), s) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // AddonContext returns the context for the given addon.
 func (c *Context) AddonContext(addonName string) interface{} {
 	if a, ok := c.addons[addonName]; ok {
@@ -305,6 +543,36 @@ func (c *Context) AddonContext(addonName string) interface{} {
 	return nil
 }
 
+// UseStructuredValidationErrors returns true if the generated validations
+// should return structured errors.
+func (c *Context) UseStructuredValidationErrors() bool {
+	return c.settings.StructuredValidationErrorsEnabled()
+}
+
+// UseContextValidation returns true if the generated validations should
+// have context-aware APIs.
+func (c *Context) UseContextValidation() bool {
+	return c.settings.ContextValidationEnabled()
+}
+
+// UseValidationMessages returns true if the generated validations should
+// support localized error messages.
+func (c *Context) UseValidationMessages() bool {
+	return c.settings.ValidationMessagesEnabled()
+}
+
+// DefaultValidationMessagesLocale returns the locale used when no locale is
+// set for the validations.
+func (c *Context) DefaultValidationMessagesLocale() string {
+	return c.settings.DefaultMessagesLocale()
+}
+
+// ValidationMessageCatalogs returns the localized validation messages that
+// are embedded into the generated code.
+func (c *Context) ValidationMessageCatalogs() []*settings.MessageCatalog {
+	return c.settings.ValidationMessageCatalogs()
+}
+
 // UseCommonConverters returns true if the common converters defined inside the
 // settings should be used.
 func (c *Context) UseCommonConverters() bool {
@@ -69,7 +69,7 @@ func loadField(opt loadFieldOptions) (*Field, error) {
 		IsMessage:                opt.Field.IsMessage(),
 		IsMap:                    opt.Field.IsMap(),
 		IsArray:                  opt.Field.IsArray(),
-		IsProtoOptional:          opt.Field.Proto.GetProto3Optional(),
+		IsProtoOptional:          opt.Field.IsOptional(),
 		Type:                     opt.Field.Proto.GetType(),
 		GoType:                   fieldMapping.Types().GoType(),
 		GoName:                   opt.Field.Schema.GoName,
@@ -90,6 +90,7 @@ func loadField(opt loadFieldOptions) (*Field, error) {
 			IsArray:    opt.Field.IsArray(),
 			GoType:     fieldMapping.Types().GoType(),
 			ProtoField: opt.Field,
+			Message:    opt.Message,
 			Settings:   opt.Settings,
 			FieldType:  fieldMapping.Types(),
 		}),
@@ -112,6 +113,18 @@ func (f *Field) Validate() error {
 		return fmt.Errorf("field '%s' cannot have a custom json struct tag", f.ProtoName)
 	}
 
+	if f.hasBytesEncoding() && !mapping.IsBytesField(f.ProtoField) {
+		return fmt.Errorf("field '%s' has an unsupported type '%s' to have a bytes encoding", f.ProtoName, f.GoType)
+	}
+
+	value, err := mapping.DomainDefaultValue(f.ProtoField)
+	if err != nil {
+		return err
+	}
+	if value != "" && f.IsRequired() {
+		return fmt.Errorf("field '%s' is required and cannot have a default value", f.ProtoName)
+	}
+
 	return nil
 }
 
@@ -119,6 +132,10 @@ func (f *Field) isBitflag() bool {
 	return f.extensions != nil && f.extensions.GetOutbound() != nil && f.extensions.GetOutbound().GetBitflag() != nil
 }
 
+func (f *Field) hasBytesEncoding() bool {
+	return f.extensions.GetOutbound().GetBytesEncoding() != extensions.BytesEncoding_BYTES_ENCODING_UNSPECIFIED
+}
+
 func (f *Field) hasJSONStructTag() bool {
 	if f.extensions == nil {
 		return false
@@ -267,6 +284,41 @@ func (f *Field) ConvertWireOutputToArrayOutbound(receiver string) string {
 	return f.Mapping.Conversion().WireOutputToArrayOutbound(receiver)
 }
 
+// DatabaseStorageType returns the type used to store the field inside the
+// database, or an empty string if it is stored with its own type.
+func (f *Field) DatabaseStorageType() string {
+	return mapping.FieldStorageType(f.ProtoField)
+}
+
+// IsOneofMember returns true if the field is declared inside a oneof.
+func (f *Field) IsOneofMember() bool {
+	return f.ProtoField.IsOneof()
+}
+
+// ConvertDomainTypeToOneofWireType converts a oneof member domain value to the
+// value of its wire oneof wrapper.
+func (f *Field) ConvertDomainTypeToOneofWireType(receiver string) string {
+	return f.Mapping.Conversion().DomainTypeToOneofWireType(receiver, false)
+}
+
+// ConvertDomainTypeToOneofWireInputType converts a oneof member domain value
+// to the value of its wire input oneof wrapper.
+func (f *Field) ConvertDomainTypeToOneofWireInputType(receiver string) string {
+	return f.Mapping.Conversion().DomainTypeToOneofWireType(receiver, true)
+}
+
+// ConvertWireTypeToOneofDomainType converts the value of a wire oneof wrapper
+// to its member domain value.
+func (f *Field) ConvertWireTypeToOneofDomainType(receiver string) string {
+	return f.Mapping.Conversion().WireTypeToOneofDomainType(receiver)
+}
+
+// ConvertWireOutputToOneofOutbound converts the value of a wire oneof wrapper
+// to its member outbound value.
+func (f *Field) ConvertWireOutputToOneofOutbound(receiver string) string {
+	return f.Mapping.Conversion().WireOutputToOneofOutbound(receiver)
+}
+
 // OutboundHide returns true if the field should be hidden from the outbound
 // representation.
 func (f *Field) OutboundHide() bool {
@@ -283,9 +335,26 @@ func (f *Field) IsProtobufValue() bool {
 	return f.ProtoField.IsProtoValue()
 }
 
+// IsRequired returns true if the field validation always requires it.
+func (f *Field) IsRequired() bool {
+	return f.Mapping.Validation().IsRequired()
+}
+
+// DefaultValue returns the Go literal of the field default value inside its
+// domain structure, or an empty string if it has none.
+func (f *Field) DefaultValue() string {
+	value, _ := mapping.DomainDefaultValue(f.ProtoField)
+	return value
+}
+
 // IsValidatable returns true if the field is validatable.
 func (f *Field) IsValidatable() bool {
-	return f.extensions != nil && f.extensions.GetValidate() != nil && !f.extensions.GetValidate().GetSkip()
+	if f.extensions != nil && f.extensions.GetValidate() != nil {
+		return !f.extensions.GetValidate().GetSkip()
+	}
+
+	validation := f.Mapping.Validation()
+	return validation.HasBufValidateRules() || validation.HasStorageTypeRule()
 }
 
 // ValidationName returns the validation call name for the field.
@@ -298,6 +367,24 @@ func (f *Field) ValidationCall() string {
 	return f.Mapping.Validation().Call()
 }
 
+// IsDomainValidatable returns true if the field is validatable inside its
+// domain structure.
+func (f *Field) IsDomainValidatable() bool {
+	return f.IsValidatable() && f.DomainValidationCall() != ""
+}
+
+// DomainValidationName returns the validation call name for the field inside
+// its domain structure.
+func (f *Field) DomainValidationName(receiver string) string {
+	return f.Mapping.Validation().DomainCallFunctionName(receiver)
+}
+
+// DomainValidationCall returns the validation call for the field inside its
+// domain structure.
+func (f *Field) DomainValidationCall() string {
+	return f.Mapping.Validation().DomainCall()
+}
+
 // TestingValueBinding returns the binding value for the field for the testing
 // templates.
 func (f *Field) TestingValueBinding() string {
@@ -37,8 +37,12 @@ func toImportsContext(ctx *Context) *imports.Context {
 		domain         []*imports.Message
 		outbound       []*imports.Message
 		validate       []*imports.Message
+		domainValidate []*imports.Message
 		wireExtensions []*imports.Message
 		wireInput      []*imports.Message
+		repositories   []*imports.Message
+		repoTypes      []string
+		queryTypes     []string
 	)
 
 	for _, m := range ctx.Methods {
@@ -46,6 +50,9 @@ func toImportsContext(ctx *Context) *imports.Context {
 			HasRequiredBody:    m.HasRequiredBody(),
 			HasQueryArguments:  m.HasHeaderArguments(),
 			HasHeaderArguments: m.HasQueryArguments(),
+			HasRawBody:         m.RawBodyField() != "",
+			HasUTF8Arguments:   m.hasUTF8Arguments(),
+			ArgumentDecoders:   m.argumentDecoders(),
 		})
 	}
 
@@ -61,6 +68,20 @@ func toImportsContext(ctx *Context) *imports.Context {
 		wireInput = append(wireInput, messageToImportMessage(m))
 	}
 
+	for _, r := range ctx.Repositories() {
+		repositories = append(repositories, messageToImportMessage(r.Message))
+		repoTypes = append(repoTypes, r.ID.DomainType())
+		for _, f := range r.Filters {
+			repoTypes = append(repoTypes, r.FilterType(f))
+		}
+	}
+
+	for _, q := range ctx.Queries() {
+		for _, c := range q.Conditions {
+			queryTypes = append(queryTypes, c.ValueType)
+		}
+	}
+
 	for _, m := range ctx.CustomAPIExtensions() {
 		wireExtensions = append(wireExtensions, messageToImportMessage(m))
 	}
@@ -69,25 +90,35 @@ func toImportsContext(ctx *Context) *imports.Context {
 		validate = append(validate, messageToImportMessage(m))
 	}
 
+	for _, m := range ctx.DomainValidatableMessages() {
+		domainValidate = append(domainValidate, messageToImportMessage(m))
+	}
+
 	return &imports.Context{
-		HasValidatableMessage:   ctx.HasValidatableMessage(),
-		OutboundHasBitflagField: ctx.OutboundHasBitflagField(),
-		UseCommonConverters:     ctx.UseCommonConverters(),
-		ModuleName:              ctx.ModuleName,
-		FullPath:                ctx.Package.FullPath,
-		Methods:                 methods,
-		DomainMessages:          domain,
-		OutboundMessages:        outbound,
-		ValidatableMessages:     validate,
-		WireExtensions:          wireExtensions,
-		WireInputMessages:       wireInput,
+		HasValidatableMessage:     ctx.HasValidatableMessage(),
+		OutboundHasBitflagField:   ctx.OutboundHasBitflagField(),
+		UseCommonConverters:       ctx.UseCommonConverters(),
+		ModuleName:                ctx.ModuleName,
+		FullPath:                  ctx.Package.FullPath,
+		Methods:                   methods,
+		DomainMessages:            domain,
+		OutboundMessages:          outbound,
+		ValidatableMessages:       validate,
+		DomainValidatableMessages: domainValidate,
+		WireExtensions:            wireExtensions,
+		WireInputMessages:         wireInput,
+		RepositoryMessages:        repositories,
+		QueryValueTypes:           queryTypes,
+		RepositoryTypes:           repoTypes,
 	}
 }
 
 func fieldToImportField(f *Field) *imports.Field {
 	return &imports.Field{
 		IsArray:                        f.IsArray,
+		IsMap:                          f.IsMap,
 		IsProtobufTimestamp:            f.ProtoField.IsTimestamp(),
+		DatabaseStorageType:            f.DatabaseStorageType(),
 		IsOutboundBitflag:              f.IsOutboundBitflag(),
 		IsMessage:                      f.IsMessageFromOtherPackage() || f.ProtoField.IsMessageFromPackage(),
 		OutboundHide:                   f.OutboundHide(),
@@ -100,6 +131,7 @@ func fieldToImportField(f *Field) *imports.Field {
 		TestingBinding:                 f.TestingValueBinding(),
 		TestingCall:                    f.TestingValueCall(),
 		ValidationCall:                 f.ValidationCall(),
+		DomainValidationCall:           f.DomainValidationCall(),
 		ProtoField:                     f.ProtoField,
 	}
 }
@@ -110,11 +142,21 @@ func messageToImportMessage(m *Message) *imports.Message {
 		fields = append(fields, fieldToImportField(f))
 	}
 
+	var indexes []string
+	for _, index := range m.MongoIndexes {
+		indexes = append(indexes, index.Keys()+index.Options())
+	}
+
 	return &imports.Message{
 		ValidationNeedsCustomRuleOptions: m.ValidationNeedsCustomRuleOptions(),
 		IsWireInputKind:                  m.IsWireInputKind(),
 		Receiver:                         m.GetReceiverName(),
 		Fields:                           fields,
 		ProtoMessage:                     m.ProtoMessage,
+		MongoIndexes:                     indexes,
+		MongoNotDeletedFilter:            m.Database.NotDeletedFilter(),
+		HasSoftDelete:                    m.Database.SoftDelete != nil,
+		HasDatabaseTimestamps:            m.Database.HasTimestamps(),
+		DatabaseKind:                     m.Database.kind,
 	}
 }
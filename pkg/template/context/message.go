@@ -1,10 +1,13 @@
 package context
 
 import (
+	"fmt"
 	"slices"
 	"sort"
 	"strings"
 
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/internal/validation"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
@@ -12,6 +15,7 @@ import (
 )
 
 const (
+	domainTemplateName   = "api:domain"
 	outboundTemplateName = "api:outbound"
 )
 
@@ -23,8 +27,11 @@ type Message struct {
 	OutboundName string
 	Type         mapping.MessageKind
 	Fields       []*Field
+	Oneofs       []*Oneof
 	ProtoMessage *protobuf.Message
 	Mapping      *mapping.Message
+	MongoIndexes []*mapping.MongoIndex
+	Database     *MessageDatabase
 
 	isHTTPService bool
 	extensions    *extensions.MikrosMessageExtensions
@@ -36,17 +43,21 @@ type loadMessagesOptions struct {
 
 func loadMessages(pkg *protobuf.Protobuf, opt loadMessagesOptions) ([]*Message, error) {
 	var (
-		messages      = make([]*Message, len(pkg.Messages))
-		isHTTPService bool
+		messages      = make([]*Message, 0, len(pkg.Messages))
+		errs          diagnostic.List
+		isHTTPService = pkg.HasHTTPService()
 	)
 
-	if pkg.Service != nil {
-		isHTTPService = pkg.Service.IsHTTP()
-	}
+	for _, m := range pkg.Messages {
+		if opt.Settings.BufValidateEnabled() {
+			if err := validation.CheckBufMessageRules(m); err != nil {
+				errs.Add(diagnostic.At(m.Schema.Desc, err))
+			}
+		}
 
-	for i, m := range pkg.Messages {
 		var (
 			fields    = make([]*Field, len(m.Fields))
+			fieldErrs diagnostic.List
 			endpoint  = getEndpointFromMessage(m.Name, pkg)
 			converter = mapping.NewMessage(mapping.MessageOptions{
 				Settings: opt.Settings,
@@ -65,24 +76,72 @@ func loadMessages(pkg *protobuf.Protobuf, opt loadMessagesOptions) ([]*Message,
 				Settings:       opt.Settings,
 			})
 			if err != nil {
-				return nil, err
+				fieldErrs.Add(diagnostic.At(f.Schema.Desc, err))
+				continue
 			}
 
 			fields[i] = field
 		}
+		if len(fieldErrs) > 0 {
+			// Oneofs can't be loaded without all their fields.
+			errs.Add(fieldErrs)
+			continue
+		}
 
-		messages[i] = &Message{
+		domainName := converter.WireToDomain(m.Name)
+		oneofs := make([]*Oneof, len(m.Oneofs))
+		for i, o := range m.Oneofs {
+			oneof, err := loadOneof(loadOneofOptions{
+				MessageDomainName: domainName,
+				Oneof:             o,
+				Message:           m,
+				Fields:            fields,
+				Settings:          opt.Settings,
+			})
+			if err != nil {
+				errs.Add(diagnostic.At(o.Schema.Desc, err))
+				continue
+			}
+
+			oneofs[i] = oneof
+		}
+
+		indexes, err := loadMongoIndexes(m, fields, opt.Settings)
+		if err != nil {
+			errs.Add(diagnostic.At(m.Schema.Desc, err))
+			continue
+		}
+
+		database, err := loadMessageDatabase(m, domainName, fields, opt.Settings)
+		if err != nil {
+			errs.Add(diagnostic.At(m.Schema.Desc, err))
+			continue
+		}
+
+		message := &Message{
 			Name:          m.Name,
-			DomainName:    converter.WireToDomain(m.Name),
+			DomainName:    domainName,
 			WireName:      converter.WireName(m.Name),
 			OutboundName:  converter.WireOutputToOutbound(m.Name),
 			Type:          converter.Kind(m.Name),
 			Fields:        fields,
+			Oneofs:        oneofs,
 			ProtoMessage:  m,
-			isHTTPService: pkg.Service != nil && pkg.Service.IsHTTP(),
+			MongoIndexes:  indexes,
+			Database:      database,
+			isHTTPService: isHTTPService,
 			Mapping:       converter,
 			extensions:    extensions.LoadMessageExtensions(m.Proto),
 		}
+		if err := checkOutboundFields(message); err != nil {
+			errs.Add(err)
+			continue
+		}
+
+		messages = append(messages, message)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
 	}
 
 	// Sort messages by name so it does not affect generated code every
@@ -94,14 +153,50 @@ func loadMessages(pkg *protobuf.Protobuf, opt loadMessagesOptions) ([]*Message,
 	return messages, nil
 }
 
+// checkOutboundFields checks if the fields of a message exported to the
+// outbound structure can be converted into it.
+func checkOutboundFields(message *Message) error {
+	if !message.OutboundExport() {
+		return nil
+	}
+
+	var errs diagnostic.List
+	for _, f := range message.Fields {
+		if f.Mapping.Conversion().IsRawBytes() {
+			errs.Add(diagnostic.At(f.ProtoField.Schema.Desc, fmt.Errorf(
+				"field '%s' cannot use BYTES_ENCODING_RAW since message '%s' is exported to outbound",
+				f.ProtoName, message.Name,
+			)))
+		}
+	}
+
+	return errs.Err()
+}
+
+func loadMongoIndexes(m *protobuf.Message, fields []*Field, cfg *settings.Settings) ([]*mapping.MongoIndex, error) {
+	if mapping.DatabaseKind(m, cfg) != "mongo" {
+		return nil, nil
+	}
+
+	names := make(map[string]string, len(fields))
+	for _, f := range fields {
+		names[f.ProtoName] = f.Mapping.Tags().DatabaseName()
+	}
+
+	return mapping.NewMongoIndexes(&mapping.MongoIndexesOptions{
+		Message:    m,
+		FieldNames: names,
+	})
+}
+
 func getReceiver(name string) string {
 	r := name[0:1]
 	return strings.ToLower(r)
 }
 
 func getEndpointFromMessage(msgName string, pkg *protobuf.Protobuf) *Endpoint {
-	if pkg.Service != nil {
-		for _, m := range pkg.Service.Methods {
+	for _, s := range pkg.Services {
+		for _, m := range s.Methods {
 			if m.RequestType.Name == msgName {
 				return getEndpoint(m)
 			}
@@ -138,14 +233,26 @@ func (m *Message) HasMapField() bool {
 	return false
 }
 
-// BindableFields returns the fields that can be bound.
+// HasMongoIndexes returns true if the message declares indexes for its mongo
+// collection.
+func (m *Message) HasMongoIndexes() bool {
+	return len(m.MongoIndexes) > 0
+}
+
+// HasOneof returns true if the message has at least one oneof.
+func (m *Message) HasOneof() bool {
+	return len(m.Oneofs) > 0
+}
+
+// BindableFields returns the fields that can be bound. Oneof members are
+// never bound directly since they depend on which one is set.
 func (m *Message) BindableFields(templateName string) []*Field {
 	filter := func(field *Field) bool {
-		return field.IsBindable()
+		return field.IsBindable() && !field.IsOneofMember()
 	}
 	if templateName == outboundTemplateName {
 		filter = func(field *Field) bool {
-			return field.IsBindable() && !field.OutboundHide()
+			return field.IsBindable() && !field.IsOneofMember() && !field.OutboundHide()
 		}
 	}
 
@@ -268,10 +375,12 @@ func (m *Message) CustomBlocks() []*CustomBlock {
 	return customBlocks
 }
 
-// GetFields returns the fields of the message.
+// GetFields returns the fields of the message. Oneof members are only
+// returned for the outbound template, where they are flattened as simple
+// fields.
 func (m *Message) GetFields(templateName string) []*Field {
 	filter := func(field *Field) bool {
-		return true
+		return !field.IsOneofMember()
 	}
 	if templateName == outboundTemplateName {
 		filter = func(field *Field) bool {
@@ -309,6 +418,12 @@ func (m *Message) HasValidatableField() bool {
 		}
 	}
 
+	for _, oneof := range m.Oneofs {
+		if oneof.IsRequired() {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -322,13 +437,16 @@ func (m *Message) ValidationNeedsCustomRuleOptions() bool {
 		}
 
 		if validation := ext.GetValidate(); validation != nil {
-			nonCustomRules := []extensions.FieldValidatorRule{
-				extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_REGEX,
-				extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_UNSPECIFIED,
-			}
-
-			if !slices.Contains(nonCustomRules, validation.GetRule()) {
-				return true
+			// Map keys and values rules are validated inside the same
+			// call of their field.
+			for _, v := range []*extensions.FieldValidateOptions{
+				validation,
+				validation.GetKeys(),
+				validation.GetValues(),
+			} {
+				if usesCustomRuleOptions(v) {
+					return true
+				}
 			}
 		}
 	}
@@ -336,6 +454,19 @@ func (m *Message) ValidationNeedsCustomRuleOptions() bool {
 	return false
 }
 
+func usesCustomRuleOptions(validation *extensions.FieldValidateOptions) bool {
+	if validation == nil {
+		return false
+	}
+
+	nonCustomRules := []extensions.FieldValidatorRule{
+		extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_REGEX,
+		extensions.FieldValidatorRule_FIELD_VALIDATOR_RULE_UNSPECIFIED,
+	}
+
+	return !slices.Contains(nonCustomRules, validation.GetRule())
+}
+
 // IsWireInputKind returns true if the message is a wire input message.
 func (m *Message) IsWireInputKind() bool {
 	return m.Type == mapping.WireInput
@@ -345,10 +476,54 @@ func (m *Message) IsWireInputKind() bool {
 func (m *Message) ValidatableFields() []*Field {
 	var fields []*Field
 	for _, f := range m.Fields {
-		if f.IsValidatable() {
+		if f.IsValidatable() && !f.IsOneofMember() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// ValidatableOneofs returns the oneofs that are validatable.
+func (m *Message) ValidatableOneofs() []*Oneof {
+	var oneofs []*Oneof
+	for _, o := range m.Oneofs {
+		if o.IsValidatable() {
+			oneofs = append(oneofs, o)
+		}
+	}
+
+	return oneofs
+}
+
+// HasDomainValidatableField returns true if the message has at least one
+// field or oneof validatable inside its domain structure.
+func (m *Message) HasDomainValidatableField() bool {
+	return len(m.DomainValidatableFields()) > 0 || len(m.DomainValidatableOneofs()) > 0
+}
+
+// DomainValidatableFields returns the fields that are validatable inside the
+// domain structure.
+func (m *Message) DomainValidatableFields() []*Field {
+	var fields []*Field
+	for _, f := range m.Fields {
+		if f.IsDomainValidatable() && !f.IsOneofMember() {
 			fields = append(fields, f)
 		}
 	}
 
 	return fields
 }
+
+// DomainValidatableOneofs returns the oneofs that are validatable inside the
+// domain structure.
+func (m *Message) DomainValidatableOneofs() []*Oneof {
+	var oneofs []*Oneof
+	for _, o := range m.Oneofs {
+		if o.IsDomainValidatable() {
+			oneofs = append(oneofs, o)
+		}
+	}
+
+	return oneofs
+}
@@ -0,0 +1,255 @@
+package context
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+)
+
+// MessageDatabase represents the database options declared by a message.
+type MessageDatabase struct {
+	Name       string
+	SoftDelete *Field
+	CreatedAt  *Field
+	UpdatedAt  *Field
+
+	// PartitionKey and SortKey are the DynamoDB keys of the domain.
+	PartitionKey *Field
+	SortKey      *Field
+
+	kind  string
+	table string
+}
+
+func loadMessageDatabase(
+	m *protobuf.Message,
+	domainName string,
+	fields []*Field,
+	cfg *settings.Settings,
+) (*MessageDatabase, error) {
+	var (
+		db       = extensions.LoadMessageExtensions(m.Proto).GetDatabase()
+		errs     diagnostic.List
+		database = &MessageDatabase{
+			Name:  db.GetName(),
+			kind:  mapping.DatabaseKind(m, cfg),
+			table: mapping.GormTableName(domainName, db),
+		}
+	)
+
+	for _, option := range []struct {
+		Name      string
+		FieldName string
+		Field     **Field
+	}{
+		{
+			Name:      "soft_delete",
+			FieldName: db.GetSoftDelete(),
+			Field:     &database.SoftDelete,
+		},
+		{
+			Name:      "created_at",
+			FieldName: db.GetCreatedAt(),
+			Field:     &database.CreatedAt,
+		},
+		{
+			Name:      "updated_at",
+			FieldName: db.GetUpdatedAt(),
+			Field:     &database.UpdatedAt,
+		},
+	} {
+		if option.FieldName == "" {
+			continue
+		}
+
+		field, err := findTimestampField(m, fields, option.Name, option.FieldName)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+
+		*option.Field = field
+	}
+
+	if kind := db.GetKind(); kind != "" && !cfg.IsSupportedDatabaseKind(kind) {
+		errs.Add(fmt.Errorf("message '%s' has an unsupported database kind '%s'", m.Name, kind))
+	}
+
+	if database.kind == "gorm" {
+		errs.Add(validateGormIndexes(m, fields, db))
+	}
+	errs.Add(database.loadDynamoDBKeys(m, fields))
+
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	return database, nil
+}
+
+func findTimestampField(m *protobuf.Message, fields []*Field, option, name string) (*Field, error) {
+	for _, f := range fields {
+		if f.ProtoName != name {
+			continue
+		}
+
+		if !f.ProtoField.IsTimestamp() || f.IsArray || f.IsOneofMember() {
+			return nil, fmt.Errorf("field '%s' must be a timestamp to be used as %s", name, option)
+		}
+
+		return f, nil
+	}
+
+	return nil, fmt.Errorf("field '%s' declared as %s not found inside message '%s'", name, option, m.Name)
+}
+
+func (d *MessageDatabase) loadDynamoDBKeys(m *protobuf.Message, fields []*Field) error {
+	var errs diagnostic.List
+
+	for _, f := range fields {
+		var (
+			db  = f.extensions.GetDatabase()
+			key **Field
+		)
+
+		switch {
+		case db.GetPartitionKey() && db.GetSortKey():
+			errs.Add(fmt.Errorf("field '%s' can't be both partition and sort key", f.ProtoName))
+			continue
+		case db.GetPartitionKey():
+			key = &d.PartitionKey
+		case db.GetSortKey():
+			key = &d.SortKey
+		default:
+			continue
+		}
+
+		if d.kind != "dynamodb" {
+			errs.Add(fmt.Errorf("field '%s' declares a key only supported by the 'dynamodb' database kind", f.ProtoName))
+			continue
+		}
+		if !f.IsScalar() || f.IsPointer() || f.IsOneofMember() {
+			errs.Add(fmt.Errorf("field '%s' must be a scalar to be used as key", f.ProtoName))
+			continue
+		}
+		if *key != nil {
+			errs.Add(fmt.Errorf("message '%s' has more than one field declared as the same key", m.Name))
+			continue
+		}
+
+		*key = f
+	}
+
+	if d.SortKey != nil && d.PartitionKey == nil {
+		errs.Add(fmt.Errorf("message '%s' declares a sort key without a partition key", m.Name))
+	}
+
+	return errs.Err()
+}
+
+func validateGormIndexes(m *protobuf.Message, fields []*Field, db *extensions.MessageDatabaseExtensions) error {
+	var errs diagnostic.List
+
+	for i, idx := range db.GetIndex() {
+		// Unnamed indexes are identified by their position inside errors.
+		indexName := idx.GetName()
+		if indexName == "" {
+			indexName = fmt.Sprintf("#%d", i+1)
+		}
+
+		if len(idx.GetField()) == 0 {
+			errs.Add(fmt.Errorf("index '%s' of message '%s' has no fields", indexName, m.Name))
+			continue
+		}
+
+		for _, f := range idx.GetField() {
+			if _, ok := findField(fields, f.GetName()); !ok {
+				errs.Add(fmt.Errorf(
+					"field '%s' declared in index '%s' not found inside message '%s'",
+					f.GetName(),
+					indexName,
+					m.Name,
+				))
+			}
+		}
+
+		// The condition is written inside the gorm struct tag, where these
+		// characters are separators.
+		if strings.ContainsAny(idx.GetWhere(), ",;\"`") {
+			errs.Add(fmt.Errorf("index '%s' has an unsupported where condition '%s'", indexName, idx.GetWhere()))
+		}
+	}
+
+	return errs.Err()
+}
+
+func findField(fields []*Field, name string) (*Field, bool) {
+	for _, f := range fields {
+		if f.ProtoName == name {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// TableName returns the table name that the gorm domain structure must use,
+// or an empty string if it uses the default one.
+func (d *MessageDatabase) TableName() string {
+	if d.kind != "gorm" {
+		return ""
+	}
+
+	return d.Name
+}
+
+// TableConstant returns the name of the table of the domain structure, for
+// database kinds whose queries are written by the services. sqlx tables
+// are always named, since the SQL schema creates them, while DynamoDB ones
+// are only when the message declares it.
+func (d *MessageDatabase) TableConstant() string {
+	switch d.kind {
+	case "sqlx":
+		return d.table
+	case "dynamodb":
+		return d.Name
+	default:
+		return ""
+	}
+}
+
+// IsSQL returns true if the domain structure is stored in a SQL table.
+func (d *MessageDatabase) IsSQL() bool {
+	return d.kind == "gorm" || d.kind == "sqlx"
+}
+
+// CollectionName returns the name of the mongo collection of the domain
+// structure, or an empty string if the message does not declare one.
+func (d *MessageDatabase) CollectionName() string {
+	if d.kind != "mongo" {
+		return ""
+	}
+
+	return d.Name
+}
+
+// HasTimestamps returns true if the message declares fields to hold its
+// creation or update times.
+func (d *MessageDatabase) HasTimestamps() bool {
+	return d.CreatedAt != nil || d.UpdatedAt != nil
+}
+
+// NotDeletedFilter returns the mongo filter that matches documents that were
+// not soft deleted, or an empty string if the message is not soft deleted.
+func (d *MessageDatabase) NotDeletedFilter() string {
+	if d.kind != "mongo" || d.SoftDelete == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("bson.D{{Key: %q, Value: nil}}", d.SoftDelete.Mapping.Tags().DatabaseName())
+}
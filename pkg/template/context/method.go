@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/stoewer/go-strcase"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
@@ -40,72 +42,129 @@ type HTTPRule struct {
 
 // MethodField represents a field of a method.
 type MethodField struct {
-	GoName    string
-	ProtoName string
-	CastType  string
+	GoName       string
+	ProtoName    string
+	CastType     string
+	BytesDecoder string
+	RawBytes     bool
+	IsOptional   bool
+
+	// ValidatesUTF8 is set for strings whose 'utf8_validation' feature is
+	// explicitly declared as VERIFY. Request arguments don't pass through the protobuf runtime, so
+	// they must be checked like it would do.
+	ValidatesUTF8 bool
 }
 
-func loadMethods(pkg *protobuf.Protobuf, messages []*Message, cfg *settings.Settings) ([]*Method, error) {
-	if pkg.Service == nil {
-		return nil, nil
+func newMethodField(field *Field) *MethodField {
+	m := &MethodField{
+		GoName:        field.GoName,
+		ProtoName:     field.ProtoName,
+		CastType:      field.GoType,
+		IsOptional:    field.IsProtoOptional,
+		ValidatesUTF8: field.ProtoField.ValidatesUTF8(),
 	}
 
+	// Only single bytes values can be decoded from request arguments.
+	if !field.IsArray && !field.IsMap {
+		m.BytesDecoder = field.Mapping.Conversion().BytesDecoder()
+		m.RawBytes = field.Mapping.Conversion().IsRawBytes()
+	}
+
+	return m
+}
+
+func loadMethods(
+	pkg *protobuf.Protobuf,
+	svc *protobuf.Service,
+	messages []*Message,
+	cfg *settings.Settings,
+) ([]*Method, error) {
 	var (
-		methods = make([]*Method, len(pkg.Service.Methods))
-		service = extensions.LoadServiceExtensions(pkg.Service.Proto)
+		methods = make([]*Method, 0, len(svc.Methods))
+		service = extensions.LoadServiceExtensions(svc.Proto)
+		errs    diagnostic.List
 	)
 
-	for i, method := range pkg.Service.Methods {
-		var (
-			msg              *Message
-			endpoint         = getEndpoint(method)
-			methodExtensions = extensions.LoadMethodExtensions(method.Proto)
-		)
+	for _, method := range svc.Methods {
+		m, err := loadMethod(pkg, method, messages, service, cfg)
+		if err != nil {
+			var desc protoreflect.Descriptor
+			if method.Schema != nil {
+				desc = method.Schema.Desc
+			}
 
-		index := slices.IndexFunc(messages, func(m *Message) bool {
-			return m.Name == method.RequestType.Name && m.Type == mapping.WireInput
-		})
-		if index != -1 {
-			msg = messages[index]
+			errs.Add(diagnostic.At(desc, err))
+			continue
 		}
 
-		path, err := getPathArguments(msg, endpoint)
-		if err != nil {
-			return nil, err
-		}
+		methods = append(methods, m)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
 
-		header, err := getHeaderArguments(msg, methodExtensions)
-		if err != nil {
-			return nil, err
-		}
+	return methods, nil
+}
 
-		if err := validateBodyArguments(msg, endpoint); err != nil {
-			return nil, err
-		}
+func loadMethod(
+	pkg *protobuf.Protobuf,
+	method *protobuf.Method,
+	messages []*Message,
+	service *extensions.MikrosServiceExtensions,
+	cfg *settings.Settings,
+) (*Method, error) {
+	var (
+		msg              *Message
+		endpoint         = getEndpoint(method)
+		methodExtensions = extensions.LoadMethodExtensions(method.Proto)
+	)
 
-		m := &Method{
-			Name:                  method.Name,
-			RequestType:           method.RequestType.Name,
-			ResponseType:          method.ResponseType.Name,
-			AdditionalHTTPMethods: getAdditionalHTTPRules(method),
-			Request:               msg,
-			PathArguments:         path,
-			QueryArguments:        getQueryArguments(msg, endpoint, methodExtensions),
-			HeaderArguments:       header,
-			ProtoMethod:           method,
-			prefixServiceName:     cfg.Templates.Routes.PrefixServiceName,
-			moduleName:            pkg.ModuleName,
-			endpoint:              endpoint,
-			service:               service,
-			method:                methodExtensions,
-		}
-		if err := m.Validate(); err != nil {
-			return nil, err
-		}
-		methods[i] = m
+	index := slices.IndexFunc(messages, func(m *Message) bool {
+		return m.Name == method.RequestType.Name && m.Type == mapping.WireInput
+	})
+	if index != -1 {
+		msg = messages[index]
 	}
 
-	return methods, nil
+	path, err := getPathArguments(msg, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := getHeaderArguments(msg, methodExtensions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBodyArguments(msg, endpoint); err != nil {
+		return nil, err
+	}
+
+	if err := validateOneofArguments(msg); err != nil {
+		return nil, err
+	}
+
+	m := &Method{
+		Name:                  method.Name,
+		RequestType:           method.RequestType.Name,
+		ResponseType:          method.ResponseType.Name,
+		AdditionalHTTPMethods: getAdditionalHTTPRules(method),
+		Request:               msg,
+		PathArguments:         path,
+		QueryArguments:        getQueryArguments(msg, endpoint, methodExtensions),
+		HeaderArguments:       header,
+		ProtoMethod:           method,
+		prefixServiceName:     cfg.Templates.Routes.PrefixServiceName,
+		moduleName:            pkg.ModuleName,
+		endpoint:              endpoint,
+		service:               service,
+		method:                methodExtensions,
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
 }
 
 func getPathArguments(m *Message, endpoint *Endpoint) ([]*MethodField, error) {
@@ -124,12 +183,7 @@ func getPathArguments(m *Message, endpoint *Endpoint) ([]*MethodField, error) {
 				)
 			}
 
-			field := m.Fields[index]
-			fields = append(fields, &MethodField{
-				GoName:    field.GoName,
-				ProtoName: field.ProtoName,
-				CastType:  field.GoType,
-			})
+			fields = append(fields, newMethodField(m.Fields[index]))
 		}
 	}
 
@@ -155,12 +209,7 @@ func getHeaderArguments(
 				return nil, fmt.Errorf("header field '%s' not found inside message '%s' definition", header, m.Name)
 			}
 
-			field := m.Fields[index]
-			fields = append(fields, &MethodField{
-				GoName:    field.GoName,
-				ProtoName: field.ProtoName,
-				CastType:  field.GoType,
-			})
+			fields = append(fields, newMethodField(m.Fields[index]))
 		}
 	}
 
@@ -194,12 +243,7 @@ func getQueryArguments(
 				return f.ProtoName == p
 			})
 			if index != -1 {
-				field := m.Fields[index]
-				fields = append(fields, &MethodField{
-					GoName:    field.GoName,
-					ProtoName: field.ProtoName,
-					CastType:  field.GoType,
-				})
+				fields = append(fields, newMethodField(m.Fields[index]))
 			}
 		}
 	}
@@ -244,6 +288,32 @@ func getBodyParameters(m *Message, endpoint *Endpoint) []string {
 	return parameters
 }
 
+func validateOneofArguments(m *Message) error {
+	// Requests that are not from the package, like google.protobuf.Empty,
+	// have no arguments to check.
+	if m == nil {
+		return nil
+	}
+
+	// Oneof members are set through their wire wrappers, so they can only be
+	// received inside the request body.
+	for _, oneof := range m.Oneofs {
+		for _, f := range oneof.Fields {
+			if f.Location != FieldLocationBody {
+				return fmt.Errorf(
+					"field '%s' from oneof '%s' cannot be received by %s inside message '%s', only by body",
+					f.ProtoName,
+					oneof.Name,
+					f.Location,
+					m.Name,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateBodyArguments(m *Message, endpoint *Endpoint) error {
 	// Checks if body parameters were declared inside the inbound message.
 	if endpoint != nil && endpoint.Body != "*" && endpoint.Body != "" {
@@ -324,6 +394,51 @@ func (m *Method) HasRequiredBody() bool {
 	return false
 }
 
+// RawBodyField returns the name of the field that receives the request body
+// as raw bytes, when the endpoint body is a single bytes field with the raw
+// encoding.
+func (m *Method) RawBodyField() string {
+	if m.endpoint == nil || m.Request == nil || m.endpoint.Body == "" || m.endpoint.Body == "*" {
+		return ""
+	}
+
+	index := slices.IndexFunc(m.Request.Fields, func(f *Field) bool {
+		return f.ProtoName == m.endpoint.Body
+	})
+	if index == -1 {
+		return ""
+	}
+
+	field := m.Request.Fields[index]
+	if field.IsArray || field.IsMap || !field.Mapping.Conversion().IsRawBytes() {
+		return ""
+	}
+
+	return field.GoName
+}
+
+func (m *Method) hasUTF8Arguments() bool {
+	for _, f := range slices.Concat(m.PathArguments, m.QueryArguments, m.HeaderArguments) {
+		if f.ValidatesUTF8 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Method) argumentDecoders() []string {
+	var decoders []string
+
+	for _, f := range append(m.PathArguments, m.QueryArguments...) {
+		if f.BytesDecoder != "" {
+			decoders = append(decoders, f.BytesDecoder)
+		}
+	}
+
+	return decoders
+}
+
 // AuthModeKey returns the key of the auth mode. If the mode is
 // AUTHORIZATION_MODE_CUSTOM, it returns the custom auth name.
 func (m *Method) AuthModeKey() string {
@@ -0,0 +1,166 @@
+package context
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+)
+
+// Oneof represents a oneof declared inside a message to be used inside
+// templates by its context.
+type Oneof struct {
+	Name       string
+	GoName     string
+	DomainName string
+	DomainType string
+	KindType   string
+	DomainTag  string
+	KindTag    string
+	InboundTag string
+	Fields     []*Field
+	ProtoOneof *protobuf.Oneof
+	Mapping    *mapping.Oneof
+}
+
+type loadOneofOptions struct {
+	MessageDomainName string
+	Oneof             *protobuf.Oneof
+	Message           *protobuf.Message
+	Fields            []*Field
+	Settings          *settings.Settings
+}
+
+func loadOneof(opt loadOneofOptions) (*Oneof, error) {
+	oneofMapping, err := mapping.NewOneof(&mapping.OneofOptions{
+		ProtoOneof:   opt.Oneof,
+		ProtoMessage: opt.Message,
+		Settings:     opt.Settings,
+		Validate:     validator.New(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		fields     []*Field
+		domainType = opt.MessageDomainName + opt.Oneof.GoName
+	)
+
+	for _, f := range opt.Fields {
+		if f.ProtoField.Oneof() == opt.Oneof {
+			fields = append(fields, f)
+		}
+	}
+
+	return &Oneof{
+		Name:       opt.Oneof.Name,
+		GoName:     opt.Oneof.GoName,
+		DomainName: oneofMapping.Domain(),
+		DomainType: domainType,
+		KindType:   domainType + "Kind",
+		DomainTag:  oneofMapping.DomainTag(),
+		KindTag:    oneofMapping.KindTag(),
+		InboundTag: oneofMapping.InboundTag(),
+		Fields:     fields,
+		ProtoOneof: opt.Oneof,
+		Mapping:    oneofMapping,
+	}, nil
+}
+
+// WrapperName returns the name of the wire type that wraps the member field
+// when it is set inside the oneof.
+func (o *Oneof) WrapperName(field *Field) string {
+	return field.ProtoField.Schema.GoIdent.GoName
+}
+
+// KindValue returns the constant name that identifies the member field as the
+// one set inside the oneof.
+func (o *Oneof) KindValue(field *Field) string {
+	return o.KindType + field.GoName
+}
+
+// OutboundFields returns the member fields that are not hidden from the
+// outbound representation.
+func (o *Oneof) OutboundFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields {
+		if !f.OutboundHide() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// IsRequired returns true if one of the oneof members must be set.
+func (o *Oneof) IsRequired() bool {
+	return o.Mapping.IsRequired()
+}
+
+// RequiredCall returns the validation call that checks if one of the oneof
+// members is set.
+func (o *Oneof) RequiredCall() string {
+	return o.Mapping.RequiredCall()
+}
+
+// IsValidatable returns true if the oneof has any validation to be made.
+func (o *Oneof) IsValidatable() bool {
+	return o.IsRequired() || len(o.ValidatableFields()) > 0
+}
+
+// ValidatableFields returns the member fields that are validatable.
+func (o *Oneof) ValidatableFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields {
+		if f.IsValidatable() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// IsDomainValidatable returns true if the oneof has any validation to be made
+// inside its domain structure.
+func (o *Oneof) IsDomainValidatable() bool {
+	return o.IsRequired() || len(o.DomainValidatableFields()) > 0
+}
+
+// DomainValidatableFields returns the member fields that are validatable
+// inside their domain structure.
+func (o *Oneof) DomainValidatableFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields {
+		if f.IsDomainValidatable() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// Location returns where the oneof is found inside an HTTP request.
+func (o *Oneof) Location() FieldLocation {
+	if len(o.Fields) == 0 {
+		return FieldLocationUnknown
+	}
+
+	// All members share the same location, since they can only be received
+	// through the request body.
+	return o.Fields[0].Location
+}
+
+// TestingDefaultField returns the member field used to initialize the oneof
+// with random values inside testing templates. Message members are not used
+// since they don't have random values.
+func (o *Oneof) TestingDefaultField() *Field {
+	for _, f := range o.Fields {
+		if !f.IsMessage {
+			return f
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package context
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
+)
+
+// Query represents the field names and the filter builder generated for a
+// domain message, which keep database queries in sync with the struct tags.
+type Query struct {
+	Name       string
+	FieldsName string
+	FilterName string
+	Message    *Message
+	Fields     []*Field
+	Conditions []*QueryCondition
+
+	kind string
+}
+
+// QueryCondition represents a method of the filter builder that adds a
+// condition over a field.
+type QueryCondition struct {
+	Name        string
+	Description string
+	ValueType   string
+	Variadic    bool
+	Field       *Field
+
+	operator queryOperator
+}
+
+type queryOperator struct {
+	suffix      string
+	description string
+	mongo       string
+	gorm        string
+}
+
+var (
+	queryEqualityOperators = []queryOperator{
+		{suffix: "Eq", description: "is equal to the value", mongo: "$eq", gorm: "clause.Eq"},
+		{suffix: "Ne", description: "is not equal to the value", mongo: "$ne", gorm: "clause.Neq"},
+		{suffix: "In", description: "is one of the values", mongo: "$in", gorm: "clause.IN"},
+	}
+	queryOrderOperators = []queryOperator{
+		{suffix: "Gt", description: "is greater than the value", mongo: "$gt", gorm: "clause.Gt"},
+		{suffix: "Gte", description: "is greater than or equal to the value", mongo: "$gte", gorm: "clause.Gte"},
+		{suffix: "Lt", description: "is less than the value", mongo: "$lt", gorm: "clause.Lt"},
+		{suffix: "Lte", description: "is less than or equal to the value", mongo: "$lte", gorm: "clause.Lte"},
+	}
+	queryNullOperator = queryOperator{
+		suffix:      "IsNull",
+		description: "is not set",
+		mongo:       "$eq",
+		gorm:        "clause.Eq",
+	}
+)
+
+// Queries returns the queries of the domain messages.
+func (c *Context) Queries() []*Query {
+	if !c.settings.Templates.Query {
+		return nil
+	}
+
+	var queries []*Query
+	for _, m := range c.DomainMessages() {
+		name := c.domainBaseName(m)
+		query := &Query{
+			Name:       name + "Query",
+			FieldsName: name + "Fields",
+			FilterName: name + "Filter",
+			Message:    m,
+			kind:       m.Database.kind,
+		}
+
+		for _, f := range m.Fields {
+			// Fields are only named when their database kind knows their
+			// names, which addon kinds may not do.
+			if f.IsOneofMember() || f.Mapping.Tags().DatabaseName() == "" {
+				continue
+			}
+
+			query.Fields = append(query.Fields, f)
+			if f.IsScalar() && query.HasBuilder() {
+				query.Conditions = append(query.Conditions, queryFieldConditions(f)...)
+			}
+		}
+		if len(query.Fields) == 0 {
+			continue
+		}
+
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+// domainBaseName returns the name of a domain message without its suffix,
+// used to name the database helpers generated for it.
+func (c *Context) domainBaseName(m *Message) string {
+	return strings.TrimSuffix(m.DomainName, c.settings.Suffix.Domain)
+}
+
+func queryFieldConditions(f *Field) []*QueryCondition {
+	operators := queryEqualityOperators
+	if isOrderedField(f) {
+		operators = slices.Concat(queryEqualityOperators, queryOrderOperators)
+	}
+
+	var (
+		conditions = make([]*QueryCondition, 0, len(operators)+1)
+		valueType  = strings.TrimPrefix(f.DomainType(), "*")
+	)
+
+	for _, op := range operators {
+		conditions = append(conditions, &QueryCondition{
+			Name:        f.DomainName + op.suffix,
+			Description: op.description,
+			ValueType:   valueType,
+			Variadic:    op.suffix == "In",
+			Field:       f,
+			operator:    op,
+		})
+	}
+	if f.IsPointer() {
+		conditions = append(conditions, &QueryCondition{
+			Name:        f.DomainName + queryNullOperator.suffix,
+			Description: queryNullOperator.description,
+			Field:       f,
+			operator:    queryNullOperator,
+		})
+	}
+
+	return conditions
+}
+
+func isOrderedField(f *Field) bool {
+	if f.ProtoField.IsTimestamp() {
+		return true
+	}
+
+	switch f.DatabaseStorageType() {
+	case mapping.StorageTypeDate, mapping.StorageTypeDecimal128:
+		return true
+	case mapping.StorageTypeObjectID:
+		return false
+	}
+
+	switch f.ProtoField.Schema.Desc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasValue returns true if the condition receives a value.
+func (q *QueryCondition) HasValue() bool {
+	return q.ValueType != ""
+}
+
+// ValueName returns the name of the condition argument.
+func (q *QueryCondition) ValueName() string {
+	if q.Variadic {
+		return "values"
+	}
+
+	return "value"
+}
+
+// MongoCondition returns the bson document of the condition.
+func (q *QueryCondition) MongoCondition(fieldName string) string {
+	value := q.ValueName()
+	if !q.HasValue() {
+		value = "nil"
+	}
+
+	return fmt.Sprintf(
+		"bson.D{{Key: %s, Value: bson.D{{Key: %q, Value: %s}}}}",
+		fieldName,
+		q.operator.mongo,
+		value,
+	)
+}
+
+// GormCondition returns the gorm clause expression of the condition. Variadic
+// conditions expect their values inside an 'args' slice.
+func (q *QueryCondition) GormCondition(fieldName string) string {
+	var (
+		op     = q.operator
+		column = fmt.Sprintf("clause.Column{Name: %s}", fieldName)
+	)
+
+	switch {
+	case q.Variadic:
+		return fmt.Sprintf("%s{Column: %s, Values: args}", op.gorm, column)
+	case !q.HasValue():
+		return fmt.Sprintf("%s{Column: %s, Value: nil}", op.gorm, column)
+	default:
+		return fmt.Sprintf("%s{Column: %s, Value: value}", op.gorm, column)
+	}
+}
+
+// IsMongo returns true if the query is built for mongo.
+func (q *Query) IsMongo() bool {
+	return q.kind == "mongo"
+}
+
+// IsGorm returns true if the query is built for gorm.
+func (q *Query) IsGorm() bool {
+	return q.kind == "gorm"
+}
+
+// HasBuilder returns true if the database kind of the query has conditions
+// built for it. Other kinds only have the database names of their fields.
+func (q *Query) HasBuilder() bool {
+	return q.IsMongo() || q.IsGorm()
+}
@@ -0,0 +1,134 @@
+package context
+
+import (
+	"strings"
+
+	"github.com/stoewer/go-strcase"
+)
+
+// Repository represents the persistence layer generated for a domain
+// message.
+type Repository struct {
+	Name       string
+	FilterName string
+	Message    *Message
+	ID         *Field
+	Filters    []*Field
+
+	kind string
+}
+
+// Repositories returns the repositories of the domain messages. Only mongo
+// and gorm messages with an identifier, i.e., a single primary key or an
+// 'id' field, have one.
+func (c *Context) Repositories() []*Repository {
+	if !c.settings.Templates.Repository {
+		return nil
+	}
+
+	var repositories []*Repository
+	for _, m := range c.DomainMessages() {
+		if kind := m.Database.kind; kind != "mongo" && kind != "gorm" {
+			continue
+		}
+
+		id, ok := repositoryID(m)
+		if !ok {
+			continue
+		}
+
+		name := c.domainBaseName(m)
+		repositories = append(repositories, &Repository{
+			Name:       name + "Repository",
+			FilterName: name + "ListFilter",
+			Message:    m,
+			ID:         id,
+			Filters:    repositoryFilters(m, id),
+			kind:       m.Database.kind,
+		})
+	}
+
+	return repositories
+}
+
+func repositoryID(m *Message) (*Field, bool) {
+	var keys []*Field
+	for _, f := range m.Fields {
+		if f.extensions.GetDatabase().GetPrimaryKey() {
+			keys = append(keys, f)
+		}
+	}
+	if len(keys) == 0 {
+		if f, ok := findField(m.Fields, "id"); ok {
+			keys = append(keys, f)
+		}
+	}
+
+	// Composite keys and keys that may not be set can't identify the
+	// domain alone.
+	if len(keys) != 1 || !isRepositoryField(keys[0]) || keys[0].IsPointer() {
+		return nil, false
+	}
+
+	return keys[0], true
+}
+
+// repositoryFilters returns the indexed fields of a message, which are the
+// ones that can be used to filter it.
+func repositoryFilters(m *Message, id *Field) []*Field {
+	indexed := make(map[string]bool)
+	for _, idx := range m.extensions.GetDatabase().GetIndex() {
+		for _, f := range idx.GetField() {
+			indexed[f.GetName()] = true
+		}
+	}
+
+	var filters []*Field
+	for _, f := range m.Fields {
+		if f == id || f == m.Database.SoftDelete || !isRepositoryField(f) {
+			continue
+		}
+
+		db := f.extensions.GetDatabase()
+		if db.GetIndex() || db.GetUnique() || db.GetUniqueIndex() || indexed[f.ProtoName] {
+			filters = append(filters, f)
+		}
+	}
+
+	return filters
+}
+
+func isRepositoryField(f *Field) bool {
+	return f.IsScalar() && !f.IsOneofMember()
+}
+
+// ImplementationName returns the name of the structure that implements the
+// repository.
+func (r *Repository) ImplementationName() string {
+	return strcase.LowerCamelCase(r.Name)
+}
+
+// FilterType returns the type of a field inside the repository filter.
+func (r *Repository) FilterType(f *Field) string {
+	return "*" + strings.TrimPrefix(f.DomainType(), "*")
+}
+
+// IsMongo returns true if the repository uses a mongo collection.
+func (r *Repository) IsMongo() bool {
+	return r.kind == "mongo"
+}
+
+// IsGorm returns true if the repository uses a gorm database.
+func (r *Repository) IsGorm() bool {
+	return r.kind == "gorm"
+}
+
+// MongoBaseFilter returns the filter that every mongo query of the
+// repository starts with.
+func (r *Repository) MongoBaseFilter() string {
+	if f := r.Message.Database.NotDeletedFilter(); f != "" {
+		return f
+	}
+
+	return "bson.D{}"
+}
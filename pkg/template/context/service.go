@@ -0,0 +1,46 @@
+package context
+
+import (
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/settings"
+)
+
+// Service represents a service to be used inside templates by its context.
+type Service struct {
+	Name         string
+	Methods      []*Method
+	ProtoService *protobuf.Service
+}
+
+func loadServices(pkg *protobuf.Protobuf, messages []*Message, cfg *settings.Settings) ([]*Service, error) {
+	var (
+		services = make([]*Service, 0, len(pkg.Services))
+		errs     diagnostic.List
+	)
+
+	for _, s := range pkg.Services {
+		methods, err := loadMethods(pkg, s, messages, cfg)
+		if err != nil {
+			// Keep loading the other services, so all of them are reported.
+			errs.Add(err)
+			continue
+		}
+
+		services = append(services, &Service{
+			Name:         s.Name,
+			Methods:      methods,
+			ProtoService: s,
+		})
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// IsHTTP returns true if the service has any HTTP methods.
+func (s *Service) IsHTTP() bool {
+	return s.ProtoService.IsHTTP()
+}
@@ -0,0 +1,330 @@
+package context
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/diagnostic"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf/extensions"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/sqlschema"
+)
+
+// SQLSchema returns the SQL schema of the package domain messages stored by
+// SQL database kinds. Only fields with a column equivalent are part of it,
+// i.e., scalars, enums, timestamps, durations, protobuf wrappers, serialized
+// fields and oneofs.
+func (c *Context) SQLSchema() (*sqlschema.Schema, error) {
+	var (
+		schema = &sqlschema.Schema{
+			Dialect: c.settings.Database.Dialect,
+		}
+		errs diagnostic.List
+	)
+
+	for _, m := range c.DomainMessages() {
+		if !m.Database.IsSQL() {
+			continue
+		}
+
+		table, err := loadSQLTable(m, c.messages, schema.Dialect)
+		if err != nil {
+			errs.Add(diagnostic.At(m.ProtoMessage.Schema.Desc, err))
+			continue
+		}
+
+		schema.Tables = append(schema.Tables, table)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+func loadSQLTable(m *Message, messages []*Message, dialect string) (*sqlschema.Table, error) {
+	var (
+		db    = m.extensions.GetDatabase()
+		table = &sqlschema.Table{
+			Name: mapping.GormTableName(m.DomainName, db),
+		}
+		errs diagnostic.List
+	)
+
+	if err := addSQLColumns(table, []*Message{m}, messages, dialect, ""); err != nil {
+		errs.Add(err)
+	}
+
+	for i, idx := range db.GetIndex() {
+		index, err := sqlMessageIndex(m, table.Name, idx, dialect)
+		if err != nil {
+			errs.Add(fmt.Errorf("index #%d: %w", i+1, err))
+			continue
+		}
+
+		table.Indexes = append(table.Indexes, index)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	setSQLPrimaryKey(table)
+	setSQLIndexedColumns(table)
+	return table, nil
+}
+
+// addSQLColumns adds the columns of the last message of path into a table.
+// The columns of embedded messages are added with their prefixes, like gorm
+// does.
+func addSQLColumns(table *sqlschema.Table, path, messages []*Message, dialect, prefix string) error {
+	var (
+		m    = path[len(path)-1]
+		errs diagnostic.List
+	)
+
+	for _, f := range m.Fields {
+		if f.IsOneofMember() {
+			continue
+		}
+
+		fieldDB := f.extensions.GetDatabase()
+		if fieldDB.GetEmbedded() || fieldDB.GetEmbeddedPrefix() != "" {
+			embedded, ok := findMessage(messages, f.ProtoField)
+			if !ok || slices.Contains(path, embedded) {
+				errs.Add(diagnostic.At(f.ProtoField.Schema.Desc, fmt.Errorf(
+					"field '%s' must embed a message declared inside the same package without embedding itself",
+					f.ProtoName,
+				)))
+				continue
+			}
+			embeddedPath := append(slices.Clone(path), embedded)
+			if err := addSQLColumns(table, embeddedPath, messages, dialect, prefix+fieldDB.GetEmbeddedPrefix()); err != nil {
+				errs.Add(err)
+			}
+
+			continue
+		}
+
+		columnType, ok := sqlFieldColumnType(f)
+		if !ok {
+			continue
+		}
+
+		column := &sqlschema.Column{
+			Name:          prefix + sqlColumnName(f),
+			Type:          columnType,
+			PrimaryKey:    fieldDB.GetPrimaryKey(),
+			AutoIncrement: fieldDB.GetAutoIncrement(),
+			Size:          int(fieldDB.GetSize()),
+			Default:       fieldDB.GetDefaultValue(),
+			Declared:      fieldDB.GetColumnType(),
+		}
+
+		if column.AutoIncrement && !columnType.IsInteger() {
+			errs.Add(diagnostic.At(f.ProtoField.Schema.Desc, fmt.Errorf(
+				"field '%s' has an unsupported type '%s' to be auto incremented",
+				f.ProtoName,
+				f.GoType,
+			)))
+			continue
+		}
+		if columnType == sqlschema.TypeJSONB && dialect != sqlschema.DialectPostgres {
+			errs.Add(diagnostic.At(f.ProtoField.Schema.Desc, fmt.Errorf(
+				"field '%s' jsonb serializer is not supported by the '%s' dialect",
+				f.ProtoName,
+				dialect,
+			)))
+			continue
+		}
+
+		// Serialized fields are stored as NULL when they are not set.
+		column.NotNull = !fieldDB.GetAllowEmpty() && !f.IsPointer() && !f.ProtoField.IsProtobufWrapper() &&
+			!isSQLSerialized(columnType)
+		table.Columns = append(table.Columns, column)
+		table.Indexes = append(table.Indexes, sqlFieldIndexes(table.Name, column.Name, fieldDB)...)
+
+		if prefix == "" && m.Database.SoftDelete == f && !fieldDB.GetIndex() {
+			// Soft deleted rows are filtered by every query.
+			table.Indexes = append(table.Indexes, &sqlschema.Index{
+				Name:    fmt.Sprintf("idx_%s_%s", table.Name, column.Name),
+				Columns: []string{column.Name},
+			})
+		}
+	}
+
+	for _, o := range m.Oneofs {
+		// The whole oneof is serialized into a single nullable column.
+		columnType := sqlschema.TypeJSON
+		if dialect == sqlschema.DialectPostgres {
+			columnType = sqlschema.TypeJSONB
+		}
+
+		table.Columns = append(table.Columns, &sqlschema.Column{
+			Name: prefix + o.Mapping.DatabaseName(),
+			Type: columnType,
+		})
+	}
+
+	return errs.Err()
+}
+
+func isSQLSerialized(t sqlschema.Type) bool {
+	return t == sqlschema.TypeJSON || t == sqlschema.TypeJSONB
+}
+
+// findMessage returns the message of a message field when it is declared
+// inside the package.
+func findMessage(messages []*Message, field *protobuf.Field) (*Message, bool) {
+	name := field.Schema.Message.Desc.FullName()
+	for _, m := range messages {
+		if m.ProtoMessage.Schema.Desc.FullName() == name {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+// sqlFieldColumnType returns the column type of a field, where serialized
+// fields are stored as JSON.
+func sqlFieldColumnType(f *Field) (sqlschema.Type, bool) {
+	switch f.extensions.GetDatabase().GetSerializer() {
+	case extensions.DatabaseSerializer_DATABASE_SERIALIZER_JSON:
+		return sqlschema.TypeJSON, true
+	case extensions.DatabaseSerializer_DATABASE_SERIALIZER_JSONB:
+		return sqlschema.TypeJSONB, true
+	}
+	if f.IsArray || f.IsMap {
+		return "", false
+	}
+
+	return sqlColumnType(f.ProtoField)
+}
+
+func sqlColumnName(f *Field) string {
+	return f.Mapping.Tags().DatabaseName()
+}
+
+func sqlColumnType(f *protobuf.Field) (sqlschema.Type, bool) {
+	if f.IsTimestamp() {
+		return sqlschema.TypeTimestamp, true
+	}
+	if f.IsDuration() {
+		return sqlschema.TypeInt64, true
+	}
+
+	kind := f.Schema.Desc.Kind()
+	if f.IsProtobufWrapper() {
+		kind = f.Schema.Desc.Message().Fields().ByName("value").Kind()
+	}
+
+	switch kind {
+	case protoreflect.BoolKind:
+		return sqlschema.TypeBool, true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return sqlschema.TypeInt32, true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return sqlschema.TypeUint32, true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return sqlschema.TypeInt64, true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return sqlschema.TypeUint64, true
+	case protoreflect.FloatKind:
+		return sqlschema.TypeFloat, true
+	case protoreflect.DoubleKind:
+		return sqlschema.TypeDouble, true
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		// Domain structures hold enums by their names.
+		return sqlschema.TypeString, true
+	case protoreflect.BytesKind:
+		return sqlschema.TypeBytes, true
+	default:
+		return "", false
+	}
+}
+
+// sqlFieldIndexes returns the indexes of a column, named as gorm names them.
+func sqlFieldIndexes(table, column string, db *extensions.FieldDatabaseOptions) []*sqlschema.Index {
+	var indexes []*sqlschema.Index
+
+	if db.GetIndex() || db.GetUniqueIndex() {
+		indexes = append(indexes, &sqlschema.Index{
+			Name:    fmt.Sprintf("idx_%s_%s", table, column),
+			Columns: []string{column},
+			Unique:  db.GetUniqueIndex(),
+		})
+	}
+	if db.GetUnique() {
+		indexes = append(indexes, &sqlschema.Index{
+			Name:    fmt.Sprintf("uni_%s_%s", table, column),
+			Columns: []string{column},
+			Unique:  true,
+		})
+	}
+
+	return indexes
+}
+
+// sqlMessageIndex returns an index declared by the message, named as its gorm
+// struct tags name it.
+func sqlMessageIndex(
+	m *Message,
+	table string,
+	idx *extensions.DatabaseIndex,
+	dialect string,
+) (*sqlschema.Index, error) {
+	if idx.GetWhere() != "" && dialect == sqlschema.DialectMySQL {
+		return nil, fmt.Errorf("where conditions are not supported by the '%s' dialect", dialect)
+	}
+
+	index := &sqlschema.Index{
+		Name:   mapping.GormIndexName(table, idx),
+		Unique: idx.GetUnique(),
+		Where:  idx.GetWhere(),
+	}
+
+	for _, name := range idx.GetField() {
+		f, ok := findField(m.Fields, name.GetName())
+		if !ok {
+			return nil, fmt.Errorf("field '%s' not found inside message '%s'", name.GetName(), m.Name)
+		}
+		if _, ok := sqlColumnType(f.ProtoField); !ok || f.IsOneofMember() || f.IsArray || f.IsMap {
+			return nil, fmt.Errorf("field '%s' has no column to be indexed", f.ProtoName)
+		}
+
+		index.Columns = append(index.Columns, sqlColumnName(f))
+		if name.GetDescending() {
+			index.Descending = append(index.Descending, sqlColumnName(f))
+		}
+	}
+
+	return index, nil
+}
+
+// setSQLIndexedColumns marks the columns used by the table indexes.
+func setSQLIndexedColumns(table *sqlschema.Table) {
+	for _, index := range table.Indexes {
+		for _, name := range index.Columns {
+			if c, ok := table.Column(name); ok {
+				c.Indexed = true
+			}
+		}
+	}
+}
+
+// setSQLPrimaryKey uses the 'id' column as the table primary key when no
+// field is declared as one, like gorm does.
+func setSQLPrimaryKey(table *sqlschema.Table) {
+	if c, ok := table.Column("id"); ok && len(table.PrimaryKey()) == 0 {
+		c.PrimaryKey = true
+	}
+
+	for _, c := range table.Columns {
+		if c.PrimaryKey {
+			c.NotNull = true
+		}
+	}
+}
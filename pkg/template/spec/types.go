@@ -26,6 +26,24 @@ type Validator interface {
 // should be executed.
 type ExecutionFunc func() bool
 
+// Scoper is an optional behavior of the templates' contexts, allowing a
+// template to be executed more than once, each time with a context restricted
+// to a part of the package, such as a single service.
+type Scoper interface {
+	GetTemplateScopes(name Name) ([]*Scope, bool)
+}
+
+// Scope is a context restricted to a part of the package.
+type Scope struct {
+	// Name identifies the scope inside the generated file name. An empty
+	// name keeps the file name used when the template is not scoped.
+	Name string
+
+	// Context is the object manipulated inside the template file for this
+	// scope.
+	Context interface{}
+}
+
 // DefaultFuncMap gives the API available for all templates to be used.
 func DefaultFuncMap() map[string]interface{} {
 	return template.FuncMap{
@@ -48,6 +48,7 @@ type Options struct {
 	Path             string
 	FilesPrefix      string `validate:"required"`
 	Plugin           *protogen.Plugin
+	Package          *protobuf.PackageInfo
 	Files            embed.FS `validate:"required"`
 	Context          Context  `validate:"required"`
 	HelperFunctions  map[string]interface{}
@@ -74,10 +75,13 @@ func Load(options Options) (*Templates, error) {
 		packageName string
 	)
 
-	if options.Plugin != nil {
-		info, err := protobuf.GetPackageInfo(options.Plugin)
-		if err != nil {
-			return nil, err
+	if info := options.Package; info != nil || options.Plugin != nil {
+		if info == nil {
+			i, err := protobuf.GetPackageInfo(options.Plugin)
+			if err != nil {
+				return nil, err
+			}
+			info = i
 		}
 
 		// module name should not have the version suffix.
@@ -183,15 +187,13 @@ func (t *Templates) Execute() ([]*Generated, error) {
 		if err != nil {
 			return nil, err
 		}
-		if g != nil {
-			gen = append(gen, g)
-		}
+		gen = append(gen, g...)
 	}
 
 	return gen, nil
 }
 
-func (t *Templates) executeSingleTemplate(tpl *Info) (*Generated, error) {
+func (t *Templates) executeSingleTemplate(tpl *Info) ([]*Generated, error) {
 	if skip, err := t.shouldSkipTemplate(tpl); err != nil || skip {
 		return nil, err
 	}
@@ -201,17 +203,35 @@ func (t *Templates) executeSingleTemplate(tpl *Info) (*Generated, error) {
 		return nil, err
 	}
 
-	buf, err := t.executeTemplate(parsedTemplate)
-	if err != nil {
-		return nil, err
+	var gen []*Generated
+	for _, scope := range t.templateScopes(tpl) {
+		buf, err := t.executeTemplate(parsedTemplate, scope.Context)
+		if err != nil {
+			return nil, err
+		}
+
+		gen = append(gen, &Generated{
+			Data:         buf,
+			Filename:     t.buildOutputFilename(tpl, scope.Name),
+			TemplateName: tpl.name,
+			Extension:    t.context.Extension(),
+		})
 	}
 
-	return &Generated{
-		Data:         buf,
-		Filename:     t.buildOutputFilename(tpl),
-		TemplateName: tpl.name,
-		Extension:    t.context.Extension(),
-	}, nil
+	return gen, nil
+}
+
+// templateScopes returns the scopes that a template must be executed with.
+// Templates that are not scoped by the context are executed only once, with
+// the whole context.
+func (t *Templates) templateScopes(tpl *Info) []*spec.Scope {
+	if scoper, ok := t.context.(spec.Scoper); ok && tpl.addon == nil {
+		if scopes, ok := scoper.GetTemplateScopes(spec.NewName(t.filesPrefix, tpl.name)); ok {
+			return scopes
+		}
+	}
+
+	return []*spec.Scope{{Context: t.context}}
 }
 
 func (t *Templates) shouldSkipTemplate(tpl *Info) (bool, error) {
@@ -249,11 +269,11 @@ func parse(key string, data []byte, helperAPI template.FuncMap) (*template.Templ
 	return t, nil
 }
 
-func (t *Templates) executeTemplate(tpl *template.Template) (*bytes.Buffer, error) {
+func (t *Templates) executeTemplate(tpl *template.Template, ctx interface{}) (*bytes.Buffer, error) {
 	var buf bytes.Buffer
 	w := bufio.NewWriter(&buf)
 
-	if err := tpl.Execute(w, t.context); err != nil {
+	if err := tpl.Execute(w, ctx); err != nil {
 		return nil, err
 	}
 	if err := w.Flush(); err != nil {
@@ -263,12 +283,15 @@ func (t *Templates) executeTemplate(tpl *template.Template) (*bytes.Buffer, erro
 	return &buf, nil
 }
 
-func (t *Templates) buildOutputFilename(tpl *Info) string {
-	// Filename: Path + Package Name + Module Name + Template Name + Extension
+func (t *Templates) buildOutputFilename(tpl *Info, scope string) string {
+	// Filename: Path + Package Name + Module Name + Scope + Template Name + Extension
 	templateName := fmt.Sprintf("%s.%s", t.moduleName, tpl.name)
 	if tpl.addon != nil {
 		templateName = fmt.Sprintf("%s.%s.%s", t.moduleName, strcase.SnakeCase(tpl.addon.Addon().Name()), tpl.name)
 	}
+	if scope != "" {
+		templateName = fmt.Sprintf("%s.%s.%s", t.moduleName, strcase.SnakeCase(scope), tpl.name)
+	}
 
 	filename := filepath.Join(
 		t.path,